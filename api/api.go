@@ -29,6 +29,7 @@ var _ api.FullNode = combined(nil)
 
 type combined interface {
 	Proxy
+	WriteProxy
 	Local
 	UnSupport
 }
@@ -38,43 +39,55 @@ type combined interface {
 type Proxy interface {
 
 	// ChainHead returns the current head of the chain.
+	//perm:read
 	ChainHead(context.Context) (*types.TipSet, error)
 
 	// ChainGetRandomnessFromTickets is used to sample the chain for randomness.
+	//perm:read
 	ChainGetRandomnessFromTickets(ctx context.Context, tsk types.TipSetKey, personalization crypto.DomainSeparationTag, randEpoch abi.ChainEpoch, entropy []byte) (abi.Randomness, error)
 
 	// ChainGetRandomnessFromBeacon is used to sample the beacon for randomness.
+	//perm:read
 	ChainGetRandomnessFromBeacon(ctx context.Context, tsk types.TipSetKey, personalization crypto.DomainSeparationTag, randEpoch abi.ChainEpoch, entropy []byte) (abi.Randomness, error)
 
 	// ChainGetBlock returns the block specified by the given CID.
+	//perm:read
 	ChainGetBlock(context.Context, cid.Cid) (*types.BlockHeader, error)
 	// ChainGetTipSet returns the tipset specified by the given TipSetKey.
+	//perm:read
 	ChainGetTipSet(context.Context, types.TipSetKey) (*types.TipSet, error)
 
 	// ChainGetBlockMessages returns messages stored in the specified block.
+	//perm:read
 	ChainGetBlockMessages(ctx context.Context, blockCid cid.Cid) (*api.BlockMessages, error)
 
 	// ChainGetParentReceipts returns receipts for messages in parent tipset of
 	// the specified block.
+	//perm:read
 	ChainGetParentReceipts(ctx context.Context, blockCid cid.Cid) ([]*types.MessageReceipt, error)
 
 	// ChainGetParentMessages returns messages stored in parent tipset of the
 	// specified block.
+	//perm:read
 	ChainGetParentMessages(ctx context.Context, blockCid cid.Cid) ([]api.Message, error)
 
 	// ChainGetTipSetByHeight looks back for a tipset at the specified epoch.
 	// If there are no blocks at the specified epoch, a tipset at an earlier epoch
 	// will be returned.
+	//perm:read
 	ChainGetTipSetByHeight(context.Context, abi.ChainEpoch, types.TipSetKey) (*types.TipSet, error)
 
 	// ChainGetGenesis returns the genesis tipset.
+	//perm:read
 	ChainGetGenesis(context.Context) (*types.TipSet, error)
 
 	// ChainTipSetWeight computes weight for the specified tipset.
+	//perm:read
 	ChainTipSetWeight(context.Context, types.TipSetKey) (types.BigInt, error)
 
 	// ChainGetMessage reads a message referenced by the specified CID from the
 	// chain blockstore.
+	//perm:read
 	ChainGetMessage(context.Context, cid.Cid) (*types.Message, error)
 
 	// MethodGroup: Beacon
@@ -83,7 +96,39 @@ type Proxy interface {
 	// BeaconGetEntry returns the beacon entry for the given filecoin epoch. If
 	// the entry has not yet been produced, the call will block until the entry
 	// becomes available
+	//perm:read
 	BeaconGetEntry(ctx context.Context, epoch abi.ChainEpoch) (*types.BeaconEntry, error)
+
+	// WalletBalance returns the balance of the given address at the current head of the chain.
+	//perm:read
+	WalletBalance(context.Context, address.Address) (types.BigInt, error)
+	// WalletVerify takes an address, a signature, and some bytes, and indicates whether the signature is valid.
+	// The address does not have to be in the wallet.
+	//perm:read
+	WalletVerify(context.Context, address.Address, []byte, *crypto.Signature) (bool, error)
+	// WalletValidateAddress validates whether a given string can be decoded as a well-formed address
+	//perm:read
+	WalletValidateAddress(context.Context, string) (address.Address, error)
+}
+
+// WriteProxy is a subset of api.FullNode.
+// Requests involved will be proxied to the choosen remote node with the write role
+type WriteProxy interface {
+	// MpoolPush pushes a signed message to mempool.
+	//perm:write
+	MpoolPush(context.Context, *types.SignedMessage) (cid.Cid, error)
+
+	// MpoolPushUntrusted pushes a signed message to mempool from untrusted sources.
+	//perm:write
+	MpoolPushUntrusted(context.Context, *types.SignedMessage) (cid.Cid, error)
+
+	// MpoolBatchPush batch pushes a signed message to mempool.
+	//perm:write
+	MpoolBatchPush(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
+
+	// MpoolBatchPushUntrusted batch pushes a signed message to mempool from untrusted sources.
+	//perm:write
+	MpoolBatchPushUntrusted(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
 }
 
 // Local is a subset of api.FullNode.
@@ -91,7 +136,100 @@ type Proxy interface {
 type Local interface {
 	// ChainNotify returns channel with chain head updates.
 	// First message is guaranteed to be of len == 1, and type == 'current'.
+	//perm:read
 	ChainNotify(context.Context) (<-chan []*api.HeadChange, error)
+
+	// GasEstimateFeeCap estimates gas fee cap
+	//perm:read
+	GasEstimateFeeCap(context.Context, *types.Message, int64, types.TipSetKey) (types.BigInt, error)
+
+	// GasEstimateGasLimit estimates gas used by the message and returns it.
+	// It fails if message fails to execute.
+	//perm:read
+	GasEstimateGasLimit(context.Context, *types.Message, types.TipSetKey) (int64, error)
+
+	// GasEstimateGasPremium estimates what gas price should be used for a
+	// message to have high likelihood of inclusion in `nblocksincl` epochs.
+
+	//perm:read
+	GasEstimateGasPremium(_ context.Context, nblocksincl uint64,
+		sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error)
+
+	// GasEstimateMessageGas estimates gas values for unset message gas fields
+	//perm:read
+	GasEstimateMessageGas(context.Context, *types.Message, *api.MessageSendSpec, types.TipSetKey) (*types.Message, error)
+
+	// MpoolGetNonce gets next nonce for the specified sender.
+	// Note that this method may not be atomic. Use MpoolPushMessage instead.
+	//perm:read
+	MpoolGetNonce(context.Context, address.Address) (uint64, error)
+
+	// MpoolPending returns pending mempool messages.
+	//perm:read
+	MpoolPending(context.Context, types.TipSetKey) ([]*types.SignedMessage, error)
+
+	//perm:read
+	MpoolSub(context.Context) (<-chan api.MpoolUpdate, error)
+
+	// SyncState returns the current status of the lotus sync system.
+	//perm:read
+	SyncState(context.Context) (*api.SyncState, error)
+
+	// SyncSubmitBlock can be used to submit a newly created block to the.
+	// network through this node
+	//perm:write
+	SyncSubmitBlock(ctx context.Context, blk *types.BlockMsg) error
+
+	//perm:read
+	MinerGetBaseInfo(context.Context, address.Address, abi.ChainEpoch, types.TipSetKey) (*api.MiningBaseInfo, error)
+	//perm:write
+	MinerCreateBlock(context.Context, *api.BlockTemplate) (*types.BlockMsg, error)
+
+	// MpoolPushMessage atomically assigns a nonce, signs, and pushes a message
+	// to mempool.
+	// maxFee is only used when GasFeeCap/GasPremium fields aren't specified
+	//
+	// When maxFee is set to 0, MpoolPushMessage will guess appropriate fee
+	// based on current chain conditions
+	//perm:sign
+	MpoolPushMessage(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec) (*types.SignedMessage, error)
+
+	// MpoolBatchPushMessage batch pushes a unsigned message to mempool.
+	//perm:sign
+	MpoolBatchPushMessage(context.Context, []*types.Message, *api.MessageSendSpec) ([]*types.SignedMessage, error)
+
+	// WalletNew creates a new address in the wallet with the given sigType.
+	// Available key types: bls, secp256k1, secp256k1-ledger
+	// Support for numerical types: 1 - secp256k1, 2 - BLS is deprecated
+	//perm:write
+	WalletNew(context.Context, types.KeyType) (address.Address, error)
+	// WalletHas indicates whether the given address is in the wallet.
+	//perm:write
+	WalletHas(context.Context, address.Address) (bool, error)
+	// WalletList lists all the addresses in the wallet.
+	//perm:write
+	WalletList(context.Context) ([]address.Address, error)
+	// WalletSign signs the given bytes using the given address.
+	//perm:sign
+	WalletSign(context.Context, address.Address, []byte) (*crypto.Signature, error)
+	// WalletSignMessage signs the given message using the given address.
+	//perm:sign
+	WalletSignMessage(context.Context, address.Address, *types.Message) (*types.SignedMessage, error)
+	// WalletDefaultAddress returns the address marked as default in the wallet.
+	//perm:write
+	WalletDefaultAddress(context.Context) (address.Address, error)
+	// WalletSetDefault marks the given address as as the default one.
+	//perm:admin
+	WalletSetDefault(context.Context, address.Address) error
+	// WalletExport returns the private key of an address in the wallet.
+	//perm:admin
+	WalletExport(context.Context, address.Address) (*types.KeyInfo, error)
+	// WalletImport receives a KeyInfo, which includes a private key, and imports it into the wallet.
+	//perm:admin
+	WalletImport(context.Context, *types.KeyInfo) (address.Address, error)
+	// WalletDelete deletes an address from the wallet.
+	//perm:write
+	WalletDelete(context.Context, address.Address) error
 }
 
 // UnSupport is a subset of api.FullNode
@@ -101,22 +239,28 @@ type UnSupport interface {
 
 	// ChainReadObj reads ipld nodes referenced by the specified CID from chain
 	// blockstore and returns raw bytes.
+	//perm:read
 	ChainReadObj(context.Context, cid.Cid) ([]byte, error)
 
 	// ChainDeleteObj deletes node referenced by the given CID
+	//perm:admin
 	ChainDeleteObj(context.Context, cid.Cid) error
 
 	// ChainHasObj checks if a given CID exists in the chain blockstore.
+	//perm:read
 	ChainHasObj(context.Context, cid.Cid) (bool, error)
 
 	// ChainStatObj returns statistics about the graph referenced by 'obj'.
 	// If 'base' is also specified, then the returned stat will be a diff
 	// between the two objects.
+	//perm:read
 	ChainStatObj(ctx context.Context, obj cid.Cid, base cid.Cid) (api.ObjStat, error)
 
 	// ChainSetHead forcefully sets current chain head. Use with caution.
+	//perm:admin
 	ChainSetHead(context.Context, types.TipSetKey) error
 
+	//perm:read
 	ChainGetNode(ctx context.Context, p string) (*api.IpldObject, error)
 
 	// ChainGetPath returns a set of revert/apply operations needed to get from
@@ -132,6 +276,7 @@ type UnSupport interface {
 	//     tRR
 	//```
 	// Would return `[revert(tBA), apply(tAB), apply(tAA)]`
+	//perm:read
 	ChainGetPath(ctx context.Context, from types.TipSetKey, to types.TipSetKey) ([]*api.HeadChange, error)
 
 	// ChainExport returns a stream of bytes with CAR dump of chain data.
@@ -139,142 +284,63 @@ type UnSupport interface {
 	// back to genesis, the entire genesis state, and the most recent 'nroots'
 	// state trees.
 	// If oldmsgskip is set, messages from before the requested roots are also not included.
+	//perm:read
 	ChainExport(ctx context.Context, nroots abi.ChainEpoch, oldmsgskip bool, tsk types.TipSetKey) (<-chan []byte, error)
 
-	// GasEstimateFeeCap estimates gas fee cap
-	GasEstimateFeeCap(context.Context, *types.Message, int64, types.TipSetKey) (types.BigInt, error)
-
-	// GasEstimateGasLimit estimates gas used by the message and returns it.
-	// It fails if message fails to execute.
-	GasEstimateGasLimit(context.Context, *types.Message, types.TipSetKey) (int64, error)
-
-	// GasEstimateGasPremium estimates what gas price should be used for a
-	// message to have high likelihood of inclusion in `nblocksincl` epochs.
-
-	GasEstimateGasPremium(_ context.Context, nblocksincl uint64,
-		sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error)
-
-	// GasEstimateMessageGas estimates gas values for unset message gas fields
-	GasEstimateMessageGas(context.Context, *types.Message, *api.MessageSendSpec, types.TipSetKey) (*types.Message, error)
-
 	// MethodGroup: Sync
 	// The Sync method group contains methods for interacting with and
 	// observing the lotus sync service.
 
-	// SyncState returns the current status of the lotus sync system.
-	SyncState(context.Context) (*api.SyncState, error)
-
-	// SyncSubmitBlock can be used to submit a newly created block to the.
-	// network through this node
-	SyncSubmitBlock(ctx context.Context, blk *types.BlockMsg) error
-
 	// SyncIncomingBlocks returns a channel streaming incoming, potentially not
 	// yet synced block headers.
+	//perm:read
 	SyncIncomingBlocks(ctx context.Context) (<-chan *types.BlockHeader, error)
 
 	// SyncCheckpoint marks a blocks as checkpointed, meaning that it won't ever fork away from it.
+	//perm:admin
 	SyncCheckpoint(ctx context.Context, tsk types.TipSetKey) error
 
 	// SyncMarkBad marks a blocks as bad, meaning that it won't ever by synced.
 	// Use with extreme caution.
+	//perm:admin
 	SyncMarkBad(ctx context.Context, bcid cid.Cid) error
 
 	// SyncUnmarkBad unmarks a blocks as bad, making it possible to be validated and synced again.
+	//perm:admin
 	SyncUnmarkBad(ctx context.Context, bcid cid.Cid) error
 
 	// SyncUnmarkAllBad purges bad block cache, making it possible to sync to chains previously marked as bad
+	//perm:admin
 	SyncUnmarkAllBad(ctx context.Context) error
 
 	// SyncCheckBad checks if a block was marked as bad, and if it was, returns
 	// the reason.
+	//perm:read
 	SyncCheckBad(ctx context.Context, bcid cid.Cid) (string, error)
 
 	// SyncValidateTipset indicates whether the provided tipset is valid or not
+	//perm:read
 	SyncValidateTipset(ctx context.Context, tsk types.TipSetKey) (bool, error)
 
 	// MethodGroup: Mpool
 	// The Mpool methods are for interacting with the message pool. The message pool
 	// manages all incoming and outgoing 'messages' going over the network.
 
-	// MpoolPending returns pending mempool messages.
-	MpoolPending(context.Context, types.TipSetKey) ([]*types.SignedMessage, error)
-
 	// MpoolSelect returns a list of pending messages for inclusion in the next block
+	//perm:read
 	MpoolSelect(context.Context, types.TipSetKey, float64) ([]*types.SignedMessage, error)
 
-	// MpoolPush pushes a signed message to mempool.
-	MpoolPush(context.Context, *types.SignedMessage) (cid.Cid, error)
-
-	// MpoolPushUntrusted pushes a signed message to mempool from untrusted sources.
-	MpoolPushUntrusted(context.Context, *types.SignedMessage) (cid.Cid, error)
-
-	// MpoolPushMessage atomically assigns a nonce, signs, and pushes a message
-	// to mempool.
-	// maxFee is only used when GasFeeCap/GasPremium fields aren't specified
-	//
-	// When maxFee is set to 0, MpoolPushMessage will guess appropriate fee
-	// based on current chain conditions
-	MpoolPushMessage(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec) (*types.SignedMessage, error)
-
-	// MpoolBatchPush batch pushes a signed message to mempool.
-	MpoolBatchPush(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
-
-	// MpoolBatchPushUntrusted batch pushes a signed message to mempool from untrusted sources.
-	MpoolBatchPushUntrusted(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
-
-	// MpoolBatchPushMessage batch pushes a unsigned message to mempool.
-	MpoolBatchPushMessage(context.Context, []*types.Message, *api.MessageSendSpec) ([]*types.SignedMessage, error)
-
-	// MpoolGetNonce gets next nonce for the specified sender.
-	// Note that this method may not be atomic. Use MpoolPushMessage instead.
-	MpoolGetNonce(context.Context, address.Address) (uint64, error)
-	MpoolSub(context.Context) (<-chan api.MpoolUpdate, error)
-
 	// MpoolClear clears pending messages from the mpool
+	//perm:write
 	MpoolClear(context.Context, bool) error
 
 	// MpoolGetConfig returns (a copy of) the current mpool config
+	//perm:read
 	MpoolGetConfig(context.Context) (*types.MpoolConfig, error)
 	// MpoolSetConfig sets the mpool config to (a copy of) the supplied config
+	//perm:write
 	MpoolSetConfig(context.Context, *types.MpoolConfig) error
 
-	// MethodGroup: Miner
-
-	MinerGetBaseInfo(context.Context, address.Address, abi.ChainEpoch, types.TipSetKey) (*api.MiningBaseInfo, error)
-	MinerCreateBlock(context.Context, *api.BlockTemplate) (*types.BlockMsg, error)
-
-	// MethodGroup: Wallet
-
-	// WalletNew creates a new address in the wallet with the given sigType.
-	// Available key types: bls, secp256k1, secp256k1-ledger
-	// Support for numerical types: 1 - secp256k1, 2 - BLS is deprecated
-	WalletNew(context.Context, types.KeyType) (address.Address, error)
-	// WalletHas indicates whether the given address is in the wallet.
-	WalletHas(context.Context, address.Address) (bool, error)
-	// WalletList lists all the addresses in the wallet.
-	WalletList(context.Context) ([]address.Address, error)
-	// WalletBalance returns the balance of the given address at the current head of the chain.
-	WalletBalance(context.Context, address.Address) (types.BigInt, error)
-	// WalletSign signs the given bytes using the given address.
-	WalletSign(context.Context, address.Address, []byte) (*crypto.Signature, error)
-	// WalletSignMessage signs the given message using the given address.
-	WalletSignMessage(context.Context, address.Address, *types.Message) (*types.SignedMessage, error)
-	// WalletVerify takes an address, a signature, and some bytes, and indicates whether the signature is valid.
-	// The address does not have to be in the wallet.
-	WalletVerify(context.Context, address.Address, []byte, *crypto.Signature) (bool, error)
-	// WalletDefaultAddress returns the address marked as default in the wallet.
-	WalletDefaultAddress(context.Context) (address.Address, error)
-	// WalletSetDefault marks the given address as as the default one.
-	WalletSetDefault(context.Context, address.Address) error
-	// WalletExport returns the private key of an address in the wallet.
-	WalletExport(context.Context, address.Address) (*types.KeyInfo, error)
-	// WalletImport receives a KeyInfo, which includes a private key, and imports it into the wallet.
-	WalletImport(context.Context, *types.KeyInfo) (address.Address, error)
-	// WalletDelete deletes an address from the wallet.
-	WalletDelete(context.Context, address.Address) error
-	// WalletValidateAddress validates whether a given string can be decoded as a well-formed address
-	WalletValidateAddress(context.Context, string) (address.Address, error)
-
 	// Other
 
 	// MethodGroup: Client
@@ -282,55 +348,78 @@ type UnSupport interface {
 	// retrieval markets as a client
 
 	// ClientImport imports file under the specified path into filestore.
+	//perm:admin
 	ClientImport(ctx context.Context, ref api.FileRef) (*api.ImportRes, error)
 	// ClientRemoveImport removes file import
+	//perm:admin
 	ClientRemoveImport(ctx context.Context, importID multistore.StoreID) error
 	// ClientStartDeal proposes a deal with a miner.
+	//perm:admin
 	ClientStartDeal(ctx context.Context, params *api.StartDealParams) (*cid.Cid, error)
 	// ClientGetDealInfo returns the latest information about a given deal.
+	//perm:read
 	ClientGetDealInfo(context.Context, cid.Cid) (*api.DealInfo, error)
 	// ClientListDeals returns information about the deals made by the local client.
+	//perm:write
 	ClientListDeals(ctx context.Context) ([]api.DealInfo, error)
 	// ClientGetDealUpdates returns the status of updated deals
+	//perm:read
 	ClientGetDealUpdates(ctx context.Context) (<-chan api.DealInfo, error)
 	// ClientGetDealStatus returns status given a code
+	//perm:read
 	ClientGetDealStatus(ctx context.Context, statusCode uint64) (string, error)
 	// ClientHasLocal indicates whether a certain CID is locally stored.
+	//perm:write
 	ClientHasLocal(ctx context.Context, root cid.Cid) (bool, error)
 	// ClientFindData identifies peers that have a certain file, and returns QueryOffers (one per peer).
+	//perm:read
 	ClientFindData(ctx context.Context, root cid.Cid, piece *cid.Cid) ([]api.QueryOffer, error)
 	// ClientMinerQueryOffer returns a QueryOffer for the specific miner and file.
+	//perm:read
 	ClientMinerQueryOffer(ctx context.Context, miner address.Address, root cid.Cid, piece *cid.Cid) (api.QueryOffer, error)
 	// ClientRetrieve initiates the retrieval of a file, as specified in the order.
+	//perm:admin
 	ClientRetrieve(ctx context.Context, order api.RetrievalOrder, ref *api.FileRef) error
 	// ClientRetrieveWithEvents initiates the retrieval of a file, as specified in the order, and provides a channel
 	// of status updates.
+	//perm:admin
 	ClientRetrieveWithEvents(ctx context.Context, order api.RetrievalOrder, ref *api.FileRef) (<-chan marketevents.RetrievalEvent, error)
 	// ClientQueryAsk returns a signed StorageAsk from the specified miner.
+	//perm:read
 	ClientQueryAsk(ctx context.Context, p peer.ID, miner address.Address) (*storagemarket.StorageAsk, error)
 	// ClientCalcCommP calculates the CommP and data size of the specified CID
+	//perm:read
 	ClientDealPieceCID(ctx context.Context, root cid.Cid) (api.DataCIDSize, error)
 	// ClientCalcCommP calculates the CommP for a specified file
+	//perm:read
 	ClientCalcCommP(ctx context.Context, inpath string) (*api.CommPRet, error)
 	// ClientGenCar generates a CAR file for the specified file.
+	//perm:write
 	ClientGenCar(ctx context.Context, ref api.FileRef, outpath string) error
 	// ClientDealSize calculates real deal data size
+	//perm:read
 	ClientDealSize(ctx context.Context, root cid.Cid) (api.DataSize, error)
 	// ClientListTransfers returns the status of all ongoing transfers of data
+	//perm:write
 	ClientListDataTransfers(ctx context.Context) ([]api.DataTransferChannel, error)
+	//perm:write
 	ClientDataTransferUpdates(ctx context.Context) (<-chan api.DataTransferChannel, error)
 	// ClientRestartDataTransfer attempts to restart a data transfer with the given transfer ID and other peer
+	//perm:write
 	ClientRestartDataTransfer(ctx context.Context, transferID datatransfer.TransferID, otherPeer peer.ID, isInitiator bool) error
 	// ClientCancelDataTransfer cancels a data transfer with the given transfer ID and other peer
+	//perm:write
 	ClientCancelDataTransfer(ctx context.Context, transferID datatransfer.TransferID, otherPeer peer.ID, isInitiator bool) error
 	// ClientRetrieveTryRestartInsufficientFunds attempts to restart stalled retrievals on a given payment channel
 	// which are stuck due to insufficient funds
+	//perm:write
 	ClientRetrieveTryRestartInsufficientFunds(ctx context.Context, paymentChannel address.Address) error
 
 	// ClientUnimport removes references to the specified file from filestore
 	//ClientUnimport(path string)
 
 	// ClientListImports lists imported files and their root CIDs
+	//perm:write
 	ClientListImports(ctx context.Context) ([]api.Import, error)
 
 	//ClientListAsks() []Ask
@@ -345,118 +434,166 @@ type UnSupport interface {
 	// StateCall applies the message to the tipset's parent state. The
 	// message is not applied on-top-of the messages in the passed-in
 	// tipset.
+	//perm:read
 	StateCall(context.Context, *types.Message, types.TipSetKey) (*api.InvocResult, error)
 	// StateReplay replays a given message, assuming it was included in a block in the specified tipset.
 	// If no tipset key is provided, the appropriate tipset is looked up.
+	//perm:read
 	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*api.InvocResult, error)
 	// StateGetActor returns the indicated actor's nonce and balance.
+	//perm:read
 	StateGetActor(ctx context.Context, actor address.Address, tsk types.TipSetKey) (*types.Actor, error)
 	// StateReadState returns the indicated actor's state.
+	//perm:read
 	StateReadState(ctx context.Context, actor address.Address, tsk types.TipSetKey) (*api.ActorState, error)
 	// StateListMessages looks back and returns all messages with a matching to or from address, stopping at the given height.
+	//perm:read
 	StateListMessages(ctx context.Context, match *api.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error)
 	// StateDecodeParams attempts to decode the provided params, based on the recipient actor address and method number.
+	//perm:read
 	StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error)
 
 	// StateNetworkName returns the name of the network the node is synced to
+	//perm:read
 	StateNetworkName(context.Context) (dtypes.NetworkName, error)
 	// StateMinerSectors returns info about the given miner's sectors. If the filter bitfield is nil, all sectors are included.
+	//perm:read
 	StateMinerSectors(context.Context, address.Address, *bitfield.BitField, types.TipSetKey) ([]*miner.SectorOnChainInfo, error)
 	// StateMinerActiveSectors returns info about sectors that a given miner is actively proving.
+	//perm:read
 	StateMinerActiveSectors(context.Context, address.Address, types.TipSetKey) ([]*miner.SectorOnChainInfo, error)
 	// StateMinerProvingDeadline calculates the deadline at some epoch for a proving period
 	// and returns the deadline-related calculations.
+	//perm:read
 	StateMinerProvingDeadline(context.Context, address.Address, types.TipSetKey) (*dline.Info, error)
 	// StateMinerPower returns the power of the indicated miner
+	//perm:read
 	StateMinerPower(context.Context, address.Address, types.TipSetKey) (*api.MinerPower, error)
 	// StateMinerInfo returns info about the indicated miner
+	//perm:read
 	StateMinerInfo(context.Context, address.Address, types.TipSetKey) (miner.MinerInfo, error)
 	// StateMinerDeadlines returns all the proving deadlines for the given miner
+	//perm:read
 	StateMinerDeadlines(context.Context, address.Address, types.TipSetKey) ([]api.Deadline, error)
 	// StateMinerPartitions returns all partitions in the specified deadline
+	//perm:read
 	StateMinerPartitions(ctx context.Context, m address.Address, dlIdx uint64, tsk types.TipSetKey) ([]api.Partition, error)
 	// StateMinerFaults returns a bitfield indicating the faulty sectors of the given miner
+	//perm:read
 	StateMinerFaults(context.Context, address.Address, types.TipSetKey) (bitfield.BitField, error)
 	// StateAllMinerFaults returns all non-expired Faults that occur within lookback epochs of the given tipset
+	//perm:read
 	StateAllMinerFaults(ctx context.Context, lookback abi.ChainEpoch, ts types.TipSetKey) ([]*api.Fault, error)
 	// StateMinerRecoveries returns a bitfield indicating the recovering sectors of the given miner
+	//perm:read
 	StateMinerRecoveries(context.Context, address.Address, types.TipSetKey) (bitfield.BitField, error)
 	// StateMinerInitialPledgeCollateral returns the precommit deposit for the specified miner's sector
+	//perm:read
 	StateMinerPreCommitDepositForPower(context.Context, address.Address, miner.SectorPreCommitInfo, types.TipSetKey) (types.BigInt, error)
 	// StateMinerInitialPledgeCollateral returns the initial pledge collateral for the specified miner's sector
+	//perm:read
 	StateMinerInitialPledgeCollateral(context.Context, address.Address, miner.SectorPreCommitInfo, types.TipSetKey) (types.BigInt, error)
 	// StateMinerAvailableBalance returns the portion of a miner's balance that can be withdrawn or spent
+	//perm:read
 	StateMinerAvailableBalance(context.Context, address.Address, types.TipSetKey) (types.BigInt, error)
 	// StateMinerSectorAllocated checks if a sector is allocated
+	//perm:read
 	StateMinerSectorAllocated(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (bool, error)
 	// StateSectorPreCommitInfo returns the PreCommit info for the specified miner's sector
+	//perm:read
 	StateSectorPreCommitInfo(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (miner.SectorPreCommitOnChainInfo, error)
 	// StateSectorGetInfo returns the on-chain info for the specified miner's sector. Returns null in case the sector info isn't found
 	// NOTE: returned info.Expiration may not be accurate in some cases, use StateSectorExpiration to get accurate
 	// expiration epoch
+	//perm:read
 	StateSectorGetInfo(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*miner.SectorOnChainInfo, error)
 	// StateSectorExpiration returns epoch at which given sector will expire
+	//perm:read
 	StateSectorExpiration(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*miner.SectorExpiration, error)
 	// StateSectorPartition finds deadline/partition with the specified sector
+	//perm:read
 	StateSectorPartition(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tok types.TipSetKey) (*miner.SectorLocation, error)
 	// StateSearchMsg searches for a message in the chain, and returns its receipt and the tipset where it was executed
+	//perm:read
 	StateSearchMsg(context.Context, cid.Cid) (*api.MsgLookup, error)
 	// StateSearchMsgLimited looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
+	//perm:read
 	StateSearchMsgLimited(ctx context.Context, msg cid.Cid, limit abi.ChainEpoch) (*api.MsgLookup, error)
 	// StateWaitMsg looks back in the chain for a message. If not found, it blocks until the
 	// message arrives on chain, and gets to the indicated confidence depth.
+	//perm:read
 	StateWaitMsg(ctx context.Context, cid cid.Cid, confidence uint64) (*api.MsgLookup, error)
 	// StateWaitMsgLimited looks back up to limit epochs in the chain for a message.
 	// If not found, it blocks until the message arrives on chain, and gets to the
 	// indicated confidence depth.
+	//perm:read
 	StateWaitMsgLimited(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch) (*api.MsgLookup, error)
 	// StateListMiners returns the addresses of every miner that has claimed power in the Power Actor
+	//perm:read
 	StateListMiners(context.Context, types.TipSetKey) ([]address.Address, error)
 	// StateListActors returns the addresses of every actor in the state
+	//perm:read
 	StateListActors(context.Context, types.TipSetKey) ([]address.Address, error)
 	// StateMarketBalance looks up the Escrow and Locked balances of the given address in the Storage Market
+	//perm:read
 	StateMarketBalance(context.Context, address.Address, types.TipSetKey) (api.MarketBalance, error)
 	// StateMarketParticipants returns the Escrow and Locked balances of every participant in the Storage Market
+	//perm:read
 	StateMarketParticipants(context.Context, types.TipSetKey) (map[string]api.MarketBalance, error)
 	// StateMarketDeals returns information about every deal in the Storage Market
+	//perm:read
 	StateMarketDeals(context.Context, types.TipSetKey) (map[string]api.MarketDeal, error)
 	// StateMarketStorageDeal returns information about the indicated deal
+	//perm:read
 	StateMarketStorageDeal(context.Context, abi.DealID, types.TipSetKey) (*api.MarketDeal, error)
 	// StateLookupID retrieves the ID address of the given address
+	//perm:read
 	StateLookupID(context.Context, address.Address, types.TipSetKey) (address.Address, error)
 	// StateAccountKey returns the public key address of the given ID address
+	//perm:read
 	StateAccountKey(context.Context, address.Address, types.TipSetKey) (address.Address, error)
 	// StateChangedActors returns all the actors whose states change between the two given state CIDs
 	// TODO: Should this take tipset keys instead?
+	//perm:read
 	StateChangedActors(context.Context, cid.Cid, cid.Cid) (map[string]types.Actor, error)
 	// StateGetReceipt returns the message receipt for the given message
+	//perm:read
 	StateGetReceipt(context.Context, cid.Cid, types.TipSetKey) (*types.MessageReceipt, error)
 	// StateMinerSectorCount returns the number of sectors in a miner's sector set and proving set
+	//perm:read
 	StateMinerSectorCount(context.Context, address.Address, types.TipSetKey) (api.MinerSectors, error)
 	// StateCompute is a flexible command that applies the given messages on the given tipset.
 	// The messages are run as though the VM were at the provided height.
+	//perm:read
 	StateCompute(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*api.ComputeStateOutput, error)
 	// StateVerifierStatus returns the data cap for the given address.
 	// Returns nil if there is no entry in the data cap table for the
 	// address.
+	//perm:read
 	StateVerifierStatus(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*abi.StoragePower, error)
 	// StateVerifiedClientStatus returns the data cap for the given address.
 	// Returns nil if there is no entry in the data cap table for the
 	// address.
+	//perm:read
 	StateVerifiedClientStatus(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*abi.StoragePower, error)
 	// StateVerifiedClientStatus returns the address of the Verified Registry's root key
+	//perm:read
 	StateVerifiedRegistryRootKey(ctx context.Context, tsk types.TipSetKey) (address.Address, error)
 	// StateDealProviderCollateralBounds returns the min and max collateral a storage provider
 	// can issue. It takes the deal size and verified status as parameters.
+	//perm:read
 	StateDealProviderCollateralBounds(context.Context, abi.PaddedPieceSize, bool, types.TipSetKey) (api.DealCollateralBounds, error)
 
 	// StateCirculatingSupply returns the exact circulating supply of Filecoin at the given tipset.
 	// This is not used anywhere in the protocol itself, and is only for external consumption.
+	//perm:read
 	StateCirculatingSupply(context.Context, types.TipSetKey) (abi.TokenAmount, error)
 	// StateVMCirculatingSupplyInternal returns an approximation of the circulating supply of Filecoin at the given tipset.
 	// This is the value reported by the runtime interface to actors code.
+	//perm:read
 	StateVMCirculatingSupplyInternal(context.Context, types.TipSetKey) (api.CirculatingSupply, error)
 	// StateNetworkVersion returns the network version at the given tipset
+	//perm:read
 	StateNetworkVersion(context.Context, types.TipSetKey) (network.Version, error)
 
 	// MethodGroup: Msig
@@ -464,29 +601,36 @@ type UnSupport interface {
 	// filecoin network
 
 	// MsigGetAvailableBalance returns the portion of a multisig's balance that can be withdrawn or spent
+	//perm:read
 	MsigGetAvailableBalance(context.Context, address.Address, types.TipSetKey) (types.BigInt, error)
 	// MsigGetVestingSchedule returns the vesting details of a given multisig.
+	//perm:read
 	MsigGetVestingSchedule(context.Context, address.Address, types.TipSetKey) (api.MsigVesting, error)
 	// MsigGetVested returns the amount of FIL that vested in a multisig in a certain period.
 	// It takes the following params: <multisig address>, <start epoch>, <end epoch>
+	//perm:read
 	MsigGetVested(context.Context, address.Address, types.TipSetKey, types.TipSetKey) (types.BigInt, error)
 
 	//MsigGetPending returns pending transactions for the given multisig
 	//wallet. Once pending transactions are fully approved, they will no longer
 	//appear here.
+	//perm:read
 	MsigGetPending(context.Context, address.Address, types.TipSetKey) ([]*api.MsigTransaction, error)
 
 	// MsigCreate creates a multisig wallet
 	// It takes the following params: <required number of senders>, <approving addresses>, <unlock duration>
 	//<initial balance>, <sender address of the create msg>, <gas price>
+	//perm:sign
 	MsigCreate(context.Context, uint64, []address.Address, abi.ChainEpoch, types.BigInt, address.Address, types.BigInt) (cid.Cid, error)
 	// MsigPropose proposes a multisig message
 	// It takes the following params: <multisig address>, <recipient address>, <value to transfer>,
 	// <sender address of the propose msg>, <method to call in the proposed message>, <params to include in the proposed message>
+	//perm:sign
 	MsigPropose(context.Context, address.Address, address.Address, types.BigInt, address.Address, uint64, []byte) (cid.Cid, error)
 
 	// MsigApprove approves a previously-proposed multisig message by transaction ID
 	// It takes the following params: <multisig address>, <proposed transaction ID> <signer address>
+	//perm:sign
 	MsigApprove(context.Context, address.Address, uint64, address.Address) (cid.Cid, error)
 
 	// MsigApproveTxnHash approves a previously-proposed multisig message, specified
@@ -495,35 +639,43 @@ type UnSupport interface {
 	// exactly the transaction you think you are.
 	// It takes the following params: <multisig address>, <proposed message ID>, <proposer address>, <recipient address>, <value to transfer>,
 	// <sender address of the approve msg>, <method to call in the proposed message>, <params to include in the proposed message>
+	//perm:sign
 	MsigApproveTxnHash(context.Context, address.Address, uint64, address.Address, address.Address, types.BigInt, address.Address, uint64, []byte) (cid.Cid, error)
 
 	// MsigCancel cancels a previously-proposed multisig message
 	// It takes the following params: <multisig address>, <proposed transaction ID>, <recipient address>, <value to transfer>,
 	// <sender address of the cancel msg>, <method to call in the proposed message>, <params to include in the proposed message>
+	//perm:sign
 	MsigCancel(context.Context, address.Address, uint64, address.Address, types.BigInt, address.Address, uint64, []byte) (cid.Cid, error)
 	// MsigAddPropose proposes adding a signer in the multisig
 	// It takes the following params: <multisig address>, <sender address of the propose msg>,
 	// <new signer>, <whether the number of required signers should be increased>
+	//perm:sign
 	MsigAddPropose(context.Context, address.Address, address.Address, address.Address, bool) (cid.Cid, error)
 	// MsigAddApprove approves a previously proposed AddSigner message
 	// It takes the following params: <multisig address>, <sender address of the approve msg>, <proposed message ID>,
 	// <proposer address>, <new signer>, <whether the number of required signers should be increased>
+	//perm:sign
 	MsigAddApprove(context.Context, address.Address, address.Address, uint64, address.Address, address.Address, bool) (cid.Cid, error)
 	// MsigAddCancel cancels a previously proposed AddSigner message
 	// It takes the following params: <multisig address>, <sender address of the cancel msg>, <proposed message ID>,
 	// <new signer>, <whether the number of required signers should be increased>
+	//perm:sign
 	MsigAddCancel(context.Context, address.Address, address.Address, uint64, address.Address, bool) (cid.Cid, error)
 	// MsigSwapPropose proposes swapping 2 signers in the multisig
 	// It takes the following params: <multisig address>, <sender address of the propose msg>,
 	// <old signer>, <new signer>
+	//perm:sign
 	MsigSwapPropose(context.Context, address.Address, address.Address, address.Address, address.Address) (cid.Cid, error)
 	// MsigSwapApprove approves a previously proposed SwapSigner
 	// It takes the following params: <multisig address>, <sender address of the approve msg>, <proposed message ID>,
 	// <proposer address>, <old signer>, <new signer>
+	//perm:sign
 	MsigSwapApprove(context.Context, address.Address, address.Address, uint64, address.Address, address.Address, address.Address) (cid.Cid, error)
 	// MsigSwapCancel cancels a previously proposed SwapSigner message
 	// It takes the following params: <multisig address>, <sender address of the cancel msg>, <proposed message ID>,
 	// <old signer>, <new signer>
+	//perm:sign
 	MsigSwapCancel(context.Context, address.Address, address.Address, uint64, address.Address, address.Address) (cid.Cid, error)
 
 	// MsigRemoveSigner proposes the removal of a signer from the multisig.
@@ -531,42 +683,65 @@ type UnSupport interface {
 	// send the message from, the address to be removed, and a boolean
 	// indicating whether or not the signing threshold should be lowered by one
 	// along with the address removal.
+	//perm:sign
 	MsigRemoveSigner(ctx context.Context, msig address.Address, proposer address.Address, toRemove address.Address, decrease bool) (cid.Cid, error)
 
 	// MarketAddBalance adds funds to the market actor
+	//perm:sign
 	MarketAddBalance(ctx context.Context, wallet, addr address.Address, amt types.BigInt) (cid.Cid, error)
 	// MarketGetReserved gets the amount of funds that are currently reserved for the address
+	//perm:sign
 	MarketGetReserved(ctx context.Context, addr address.Address) (types.BigInt, error)
 	// MarketReserveFunds reserves funds for a deal
+	//perm:sign
 	MarketReserveFunds(ctx context.Context, wallet address.Address, addr address.Address, amt types.BigInt) (cid.Cid, error)
 	// MarketReleaseFunds releases funds reserved by MarketReserveFunds
+	//perm:sign
 	MarketReleaseFunds(ctx context.Context, addr address.Address, amt types.BigInt) error
 	// MarketWithdraw withdraws unlocked funds from the market actor
+	//perm:sign
 	MarketWithdraw(ctx context.Context, wallet, addr address.Address, amt types.BigInt) (cid.Cid, error)
 
 	// MethodGroup: Paych
 	// The Paych methods are for interacting with and managing payment channels
 
+	//perm:sign
 	PaychGet(ctx context.Context, from, to address.Address, amt types.BigInt) (*api.ChannelInfo, error)
+	//perm:sign
 	PaychGetWaitReady(context.Context, cid.Cid) (address.Address, error)
+	//perm:sign
 	PaychAvailableFunds(ctx context.Context, ch address.Address) (*api.ChannelAvailableFunds, error)
+	//perm:sign
 	PaychAvailableFundsByFromTo(ctx context.Context, from, to address.Address) (*api.ChannelAvailableFunds, error)
+	//perm:read
 	PaychList(context.Context) ([]address.Address, error)
+	//perm:read
 	PaychStatus(context.Context, address.Address) (*api.PaychStatus, error)
+	//perm:sign
 	PaychSettle(context.Context, address.Address) (cid.Cid, error)
+	//perm:sign
 	PaychCollect(context.Context, address.Address) (cid.Cid, error)
+	//perm:sign
 	PaychAllocateLane(ctx context.Context, ch address.Address) (uint64, error)
+	//perm:sign
 	PaychNewPayment(ctx context.Context, from, to address.Address, vouchers []api.VoucherSpec) (*api.PaymentInfo, error)
+	//perm:read
 	PaychVoucherCheckValid(context.Context, address.Address, *paych.SignedVoucher) error
+	//perm:read
 	PaychVoucherCheckSpendable(context.Context, address.Address, *paych.SignedVoucher, []byte, []byte) (bool, error)
+	//perm:sign
 	PaychVoucherCreate(context.Context, address.Address, types.BigInt, uint64) (*api.VoucherCreateResult, error)
+	//perm:write
 	PaychVoucherAdd(context.Context, address.Address, *paych.SignedVoucher, []byte, types.BigInt) (types.BigInt, error)
+	//perm:write
 	PaychVoucherList(context.Context, address.Address) ([]*paych.SignedVoucher, error)
+	//perm:sign
 	PaychVoucherSubmit(context.Context, address.Address, *paych.SignedVoucher, []byte, []byte) (cid.Cid, error)
 
 	// CreateBackup creates node backup onder the specified file name. The
 	// method requires that the lotus daemon is running with the
 	// LOTUS_BACKUP_BASE_PATH environment variable set to some path, and that
 	// the path specified when calling CreateBackup is within the base path
+	//perm:admin
 	CreateBackup(ctx context.Context, fpath string) error
 }
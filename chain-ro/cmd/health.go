@@ -0,0 +1,30 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/dtynn/chain-co/chain-ro/service"
+)
+
+// healthHandler serves the health report as json, it responds 503 if ready is required and the service is not ready yet
+func healthHandler(admin service.AdminAPI, requireReady bool) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		report, err := admin.Health(req.Context())
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		code := http.StatusOK
+		if requireReady && !report.Ready {
+			code = http.StatusServiceUnavailable
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(code)
+		if err := json.NewEncoder(rw).Encode(report); err != nil {
+			log.Warnf("write health report: %s", err)
+		}
+	}
+}
@@ -9,12 +9,15 @@ import (
 	"os/signal"
 	"syscall"
 
+	"contrib.go.opencensus.io/exporter/prometheus"
 	"github.com/dtynn/dix"
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/lotus/api"
+
+	"github.com/dtynn/chain-co/chain-ro/service"
 )
 
-func serveRPC(ctx context.Context, listen string, full api.FullNode, stop dix.StopFunc, maxRequestSize int64) error {
+func serveRPC(ctx context.Context, listen string, full api.FullNode, admin service.AdminAPI, stop dix.StopFunc, maxRequestSize int64) error {
 	rpcOpts := []jsonrpc.ServerOption{}
 	if maxRequestSize > 0 {
 		rpcOpts = append(rpcOpts, jsonrpc.WithMaxRequestSize(maxRequestSize))
@@ -22,9 +25,21 @@ func serveRPC(ctx context.Context, listen string, full api.FullNode, stop dix.St
 
 	rpcServer := jsonrpc.NewServer(rpcOpts...)
 	rpcServer.Register("Filecoin", full)
+	rpcServer.Register("ChainCo", admin)
 
 	http.Handle("/rpc/v0", rpcServer)
 
+	exporter, err := prometheus.NewExporter(prometheus.Options{
+		Namespace: "chainco",
+	})
+	if err != nil {
+		return err
+	}
+
+	http.Handle("/debug/metrics", exporter)
+	http.Handle("/healthz", healthHandler(admin, false))
+	http.Handle("/readyz", healthHandler(admin, true))
+
 	server := http.Server{
 		Addr:    listen,
 		Handler: http.DefaultServeMux,
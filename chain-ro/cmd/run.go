@@ -5,9 +5,12 @@ import (
 
 	"github.com/filecoin-project/lotus/api"
 	"github.com/urfave/cli/v2"
+	"go.opencensus.io/stats/view"
 
 	"github.com/dtynn/chain-co/chain-ro/service"
+	"github.com/dtynn/chain-co/co"
 	"github.com/dtynn/chain-co/dep"
+	"github.com/dtynn/chain-co/metrics"
 )
 
 var runCmd = &cli.Command{
@@ -24,28 +27,75 @@ var runCmd = &cli.Command{
 			Usage: "max request size",
 			Value: 10 << 20,
 		},
+		&cli.StringFlag{
+			Name:  "config",
+			Usage: "path to the config file",
+		},
 		&cli.StringSliceFlag{
 			Name:  "node",
-			Usage: "node info",
+			Usage: "node info, token:multiaddr[;weight=1][;roles=notify,read,write,archive][;api-timeout=10s][;relisten-min=4s][;relisten-max=32s][;label.name=value]",
+		},
+		&cli.DurationFlag{
+			Name:  "max-head-drift",
+			Usage: "max distance between now and the timestamp of the best head for the service to be ready",
+			Value: service.DefaultHealthOption().MaxHeadDrift,
+		},
+		&cli.Int64Flag{
+			Name:  "archive-horizon",
+			Usage: "requests for the epochs older than this distance from the best head will be routed to the archive nodes, 0 to disable",
+			Value: int64(co.DefaultArchiveOption().Horizon),
+		},
+		&cli.StringFlag{
+			Name:  "genesis",
+			Usage: "expected genesis block cid of the upstream nodes, taken from the first connected node if empty",
+		},
+		&cli.StringFlag{
+			Name:  "network-name",
+			Usage: "expected network name of the upstream nodes, taken from the first connected node if empty",
 		},
 	},
 	Action: func(cctx *cli.Context) error {
 		appCtx, appCancel := context.WithCancel(cctx.Context)
 		defer appCancel()
 
+		if err := view.Register(metrics.DefaultViews...); err != nil {
+			return err
+		}
+
+		cfg := &service.Config{}
+		if path := cctx.String("config"); path != "" {
+			loaded, err := service.LoadConfig(path)
+			if err != nil {
+				return err
+			}
+
+			cfg = loaded
+		}
+
 		var full api.FullNode
+		var admin service.AdminAPI
 
 		stop, err := service.Build(
 			appCtx,
 
 			dep.MetricsCtxOption(appCtx, cliName),
 
-			service.ParseNodeInfoList(cctx.StringSlice("node")),
+			service.ParseNodeInfoList(cctx.StringSlice("node"), cfg.Nodes),
+			service.ParseRouteTable(cfg.Routes),
+			service.ParseGasOption(cfg.Gas),
+			service.ParseNonceOption(cfg.Nonce),
+			service.ParseWalletOption(cfg.Wallet),
+			service.ParseHedgeOption(cfg.Hedge),
+			service.ParseTimeoutOption(cfg.Timeout),
+			service.ParseNetworkOption(cctx.String("genesis"), cctx.String("network-name")),
+			service.HealthCheck(cctx.Duration("max-head-drift")),
+			service.ArchiveHorizon(cctx.Int64("archive-horizon")),
 			service.FullNode(&full),
+			service.Admin(&admin),
 		)
 
 		if err != nil {
-			return nil
+			return err
 		}
 
 		defer stop(context.Background())
@@ -54,6 +104,7 @@ var runCmd = &cli.Command{
 			appCtx,
 			cctx.String("listen"),
 			full,
+			admin,
 			func(ctx context.Context) error {
 				appCancel()
 				stop(ctx)
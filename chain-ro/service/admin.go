@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+	"go.uber.org/fx"
+
+	"github.com/dtynn/chain-co/co"
+)
+
+// AdminAPI contains the methods for inspecting chain-co itself
+type AdminAPI interface {
+	// ListForks returns the upstream nodes whose heads are on a different branch from the best head.
+	ListForks(context.Context) ([]co.ForkInfo, error)
+
+	// Health returns the state of the best head and each upstream node.
+	Health(context.Context) (*HealthReport, error)
+}
+
+// DefaultHealthOption returns default options
+func DefaultHealthOption() HealthOption {
+	return HealthOption{
+		MaxHeadDrift: 2 * time.Minute,
+	}
+}
+
+// HealthOption is for health check configuration
+type HealthOption struct {
+	// MaxHeadDrift is the max distance between now and the timestamp of the best head,
+	// for the service to be ready, and for SyncState to report the syncs as complete
+	MaxHeadDrift time.Duration
+}
+
+// HealthReport describes the state of the service
+type HealthReport struct {
+	// Ready is true if there is at least one healthy upstream node, and the best head is fresh enough
+	Ready bool
+
+	Head   types.TipSetKey
+	Height abi.ChainEpoch
+
+	// HeadDrift is the seconds between now and the timestamp of the best head
+	HeadDrift int64
+
+	Nodes []co.NodeStatus
+}
+
+var _ AdminAPI = (*AdminService)(nil)
+
+// AdminService impls AdminAPI
+type AdminService struct {
+	fx.In
+
+	Coordinator *co.Coordinator
+	Selector    *co.Selector
+	Connector   *co.Connector
+	HealthOpt   HealthOption
+}
+
+// ListForks impls AdminAPI.ListForks
+func (a *AdminService) ListForks(ctx context.Context) ([]co.ForkInfo, error) {
+	return a.Coordinator.ListForks(ctx)
+}
+
+// Health impls AdminAPI.Health
+func (a *AdminService) Health(ctx context.Context) (*HealthReport, error) {
+	report := &HealthReport{}
+
+	healthy := 0
+	for _, node := range a.Selector.Nodes() {
+		st := node.Status()
+		if st.Healthy() {
+			healthy++
+		}
+
+		report.Nodes = append(report.Nodes, st)
+	}
+
+	report.Nodes = append(report.Nodes, a.Connector.Dialing()...)
+
+	head := a.Coordinator.Head()
+	if head == nil {
+		return report, nil
+	}
+
+	report.Head = head.Key()
+	report.Height = head.Height()
+	report.HeadDrift = time.Now().Unix() - int64(head.MinTimestamp())
+	report.Ready = healthy > 0 && report.HeadDrift < int64(a.HealthOpt.MaxHeadDrift/time.Second)
+
+	return report, nil
+}
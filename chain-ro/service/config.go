@@ -0,0 +1,192 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/dtynn/chain-co/co"
+)
+
+// Duration is a wrapper type for time.Duration, for decoding human-readable durations like "10s" in the config file
+type Duration time.Duration
+
+// UnmarshalText implements interface for TOML decoding
+func (dur *Duration) UnmarshalText(text []byte) error {
+	d, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*dur = Duration(d)
+	return nil
+}
+
+// MarshalText implements interface for TOML encoding
+func (dur Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(dur).String()), nil
+}
+
+// Config is the content of the config file
+type Config struct {
+	Nodes   []NodeConfig
+	Routes  []RouteConfig
+	Gas     GasConfig
+	Nonce   NonceConfig
+	Wallet  WalletConfig
+	Hedge   HedgeConfig
+	Timeout TimeoutConfig
+}
+
+// TimeoutConfig is for the timeouts of the proxied calls in the config file.
+// Long-polling methods like StateWaitMsg and BeaconGetEntry are only limited by their own entries,
+// and methods returning channels are never limited.
+type TimeoutConfig struct {
+	// Default is applied to the methods without a specific timeout, no timeout by default
+	Default Duration
+
+	// Methods maps the method names, or prefixes ending with "*", to the timeouts, e.g. "State*" = "30s"
+	Methods map[string]Duration
+}
+
+// HedgeConfig is for the hedged requests in the config file
+type HedgeConfig struct {
+	// Methods are the method names, or prefixes ending with "*", to be hedged.
+	// Only the methods requiring the read permission are allowed.
+	Methods []string
+
+	// Percentile of the recent latencies used as the delay before the backup request, 0.95 by default
+	Percentile float64
+
+	// MinDelay and MaxDelay bound the delay, 50ms and 2s by default
+	MinDelay Duration
+	MaxDelay Duration
+}
+
+// WalletConfig is for the wallet backend in the config file,
+// the wallet methods are rejected if neither is specified
+type WalletConfig struct {
+	// Signer is the api info of a dedicated lotus node used for signing, in the format of token:maddr
+	Signer string
+
+	// Remote is the api info of a lotus-wallet instance, in the format of token:maddr
+	Remote string
+}
+
+// NonceConfig is for the local nonce tracker in the config file
+type NonceConfig struct {
+	// Track enables tracking the nonces of the messages pushed through chain-co
+	Track bool
+
+	// TTL is how long a tracked nonce will be kept after the last push, 10m by default
+	TTL Duration
+}
+
+// GasConfig is for gas estimation in the config file
+type GasConfig struct {
+	// Aggregate enables querying several upstream nodes and combining the results
+	Aggregate bool
+
+	// Fanout is the max number of nodes to query when aggregating, 3 by default
+	Fanout int
+
+	// DefaultMaxFee is used if the MaxFee of a message is not specified, in FIL, 0.007 by default
+	DefaultMaxFee string
+}
+
+// NodeConfig describes an upstream node in the config file
+type NodeConfig struct {
+	// API is in the form of token:multiaddr
+	API string
+
+	Weight int
+	Roles  []string
+	Labels map[string]string
+
+	APITimeout          Duration
+	ReListenMinInterval Duration
+	ReListenMaxInterval Duration
+}
+
+// LoadConfig reads the config file from the given path
+func LoadConfig(path string) (*Config, error) {
+	var cfg Config
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		return nil, fmt.Errorf("decode config file %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// NodeInfo converts the config into a co.NodeInfo
+func (nc NodeConfig) NodeInfo() (co.NodeInfo, error) {
+	info, err := co.ParseNodeInfo(nc.API)
+	if err != nil {
+		return info, err
+	}
+
+	if nc.Weight < 0 {
+		return info, fmt.Errorf("negative weight %d for %s", nc.Weight, info.Host)
+	}
+
+	if nc.Weight > 0 {
+		info.Weight = nc.Weight
+	}
+
+	if len(nc.Roles) > 0 {
+		roles := co.NodeRole(0)
+		for _, r := range nc.Roles {
+			role, err := co.ParseNodeRole(r)
+			if err != nil {
+				return info, err
+			}
+
+			roles |= role
+		}
+
+		info.Roles = roles
+	}
+
+	for k, v := range nc.Labels {
+		info.Labels[k] = v
+	}
+
+	info.Opt.APITimeout = time.Duration(nc.APITimeout)
+	info.Opt.ReListenMinInterval = time.Duration(nc.ReListenMinInterval)
+	info.Opt.ReListenMaxInterval = time.Duration(nc.ReListenMaxInterval)
+
+	return info, nil
+}
+
+// RouteConfig maps the matching methods to a routing policy in the config file
+type RouteConfig struct {
+	// Method is either an exact method name, or a prefix ending with "*", e.g. "State*".
+	// The methods served by chain-co itself and the admin methods are only routed by exact names.
+	Method string
+
+	// Policy is one of "best", "role", "broadcast" and "reject"
+	Policy string
+
+	// Role is the required node role for the policy, "read" by default
+	Role string
+}
+
+// Route converts the config into a co.Route
+func (rc RouteConfig) Route() (co.Route, error) {
+	route := co.Route{
+		Pattern: rc.Method,
+		Policy:  co.RoutePolicy(rc.Policy),
+	}
+
+	if rc.Role != "" {
+		role, err := co.ParseNodeRole(rc.Role)
+		if err != nil {
+			return route, err
+		}
+
+		route.Role = role
+	}
+
+	return route, nil
+}
@@ -3,28 +3,48 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dtynn/dix"
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
 	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
 	"go.uber.org/fx"
 
 	"github.com/dtynn/chain-co/co"
 	"github.com/dtynn/chain-co/proxy"
 )
 
-const extractFullNodeAPIKey dix.Invoke = 1
+const (
+	extractFullNodeAPIKey dix.Invoke = iota + 1
+	extractAdminAPIKey
+)
 
 // Build constructs the app with given di options
 func Build(ctx context.Context, overrides ...dix.Option) (dix.StopFunc, error) {
 	opts := []dix.Option{
 		dix.Override(new(co.NodeOption), co.DefaultNodeOption),
+		dix.Override(new(co.NetworkOption), co.DefaultNetworkOption),
+		dix.Override(new(co.ArchiveOption), co.DefaultArchiveOption),
+		dix.Override(new(co.GasOption), co.DefaultGasOption),
+		dix.Override(new(co.NonceOption), co.DefaultNonceOption),
+		dix.Override(new(co.WalletOption), co.DefaultWalletOption),
+		dix.Override(new(co.HedgeOption), co.DefaultHedgeOption),
+		dix.Override(new(co.TimeoutOption), co.DefaultTimeoutOption),
+		dix.Override(new(HealthOption), DefaultHealthOption),
 		dix.Override(new(*co.Ctx), co.NewCtx),
 		dix.Override(new(*co.Connector), co.NewConnector),
 		dix.Override(new(*co.Coordinator), buildCoordinator),
 		dix.Override(new(*co.Selector), co.NewSelector),
+		dix.Override(new(*co.Wallet), co.NewWallet),
+		dix.Override(new(co.RouteTable), func() co.RouteTable { return nil }),
+		dix.Override(new(*co.Router), co.NewRouter),
 		dix.Override(new(*proxy.Proxy), buildProxyAPI),
+		dix.Override(new(*proxy.WriteProxy), buildWriteProxyAPI),
 		dix.Override(new(*proxy.Local), buildLocalAPI),
 		dix.Override(new(*proxy.UnSupport), buildUnSupportAPI),
 	}
@@ -40,12 +60,33 @@ func FullNode(full *api.FullNode) dix.Option {
 	})
 }
 
-// ParseNodeInfoList is provided to the higer-lvel
-func ParseNodeInfoList(raws []string) dix.Option {
+// Admin extracts AdminAPI from inside di
+func Admin(admin *AdminAPI) dix.Option {
+	return dix.Override(extractAdminAPIKey, func(srv AdminService) error {
+		*admin = &srv
+		return nil
+	})
+}
+
+// HealthCheck sets the max head drift for the service to be ready
+func HealthCheck(maxHeadDrift time.Duration) dix.Option {
+	return dix.Override(new(HealthOption), func() HealthOption {
+		return HealthOption{
+			MaxHeadDrift: maxHeadDrift,
+		}
+	})
+}
+
+// ParseNodeInfoList is provided to the higer-lvel, nodes can be specified both in the flags and the config file
+func ParseNodeInfoList(raws []string, cfgs []NodeConfig) dix.Option {
 	return dix.Override(new(co.NodeInfoList), func() (co.NodeInfoList, error) {
-		list := make(co.NodeInfoList, 0, len(raws))
+		list := make(co.NodeInfoList, 0, len(raws)+len(cfgs))
 		for _, str := range raws {
-			info := co.ParseNodeInfo(str)
+			info, err := co.ParseNodeInfo(str)
+			if err != nil {
+				return nil, fmt.Errorf("invalid node info %s: %w", str, err)
+			}
+
 			if _, err := info.DialArgs(); err != nil {
 				return nil, fmt.Errorf("invalid node info: %s", str)
 			}
@@ -53,11 +94,184 @@ func ParseNodeInfoList(raws []string) dix.Option {
 			list = append(list, info)
 		}
 
+		for _, cfg := range cfgs {
+			info, err := cfg.NodeInfo()
+			if err != nil {
+				return nil, fmt.Errorf("invalid node config %s: %w", cfg.API, err)
+			}
+
+			if _, err := info.DialArgs(); err != nil {
+				return nil, fmt.Errorf("invalid node config: %s", cfg.API)
+			}
+
+			list = append(list, info)
+		}
+
 		return list, nil
 	})
 }
 
-func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, infos co.NodeInfoList, sel *co.Selector) (*co.Coordinator, error) {
+// ParseRouteTable is provided to the higher-level
+func ParseRouteTable(cfgs []RouteConfig) dix.Option {
+	return dix.Override(new(co.RouteTable), func() (co.RouteTable, error) {
+		table := make(co.RouteTable, 0, len(cfgs))
+		for _, cfg := range cfgs {
+			route, err := cfg.Route()
+			if err != nil {
+				return nil, fmt.Errorf("invalid route for %s: %w", cfg.Method, err)
+			}
+
+			table = append(table, route)
+		}
+
+		return table, nil
+	})
+}
+
+// ArchiveHorizon sets the distance from the best head, beyond which requests will be routed to the archive nodes
+func ArchiveHorizon(horizon int64) dix.Option {
+	return dix.Override(new(co.ArchiveOption), func() co.ArchiveOption {
+		return co.ArchiveOption{
+			Horizon: abi.ChainEpoch(horizon),
+		}
+	})
+}
+
+// ParseGasOption is provided to the higher-level
+func ParseGasOption(cfg GasConfig) dix.Option {
+	return dix.Override(new(co.GasOption), func() (co.GasOption, error) {
+		opt := co.DefaultGasOption()
+		opt.Aggregate = cfg.Aggregate
+		if cfg.Fanout < 0 {
+			return opt, fmt.Errorf("negative gas estimation fanout %d", cfg.Fanout)
+		}
+
+		if cfg.Fanout > 0 {
+			opt.Fanout = cfg.Fanout
+		}
+
+		if cfg.DefaultMaxFee != "" {
+			maxFee, err := types.ParseFIL(cfg.DefaultMaxFee)
+			if err != nil {
+				return opt, fmt.Errorf("parse default max fee: %w", err)
+			}
+
+			opt.DefaultMaxFee = types.BigInt(maxFee)
+		}
+
+		return opt, nil
+	})
+}
+
+// ParseNonceOption is provided to the higher-level
+func ParseNonceOption(cfg NonceConfig) dix.Option {
+	return dix.Override(new(co.NonceOption), func() co.NonceOption {
+		opt := co.DefaultNonceOption()
+		opt.Track = cfg.Track
+		if cfg.TTL > 0 {
+			opt.TTL = time.Duration(cfg.TTL)
+		}
+
+		return opt
+	})
+}
+
+// ParseHedgeOption is provided to the higher-level
+func ParseHedgeOption(cfg HedgeConfig) dix.Option {
+	return dix.Override(new(co.HedgeOption), func() (co.HedgeOption, error) {
+		opt := co.DefaultHedgeOption()
+		opt.Methods = cfg.Methods
+
+		// a hedged call may reach two nodes, which is only safe for the reads
+		for _, pattern := range cfg.Methods {
+			for _, perms := range []map[string]string{proxy.ProxyPerms, proxy.WriteProxyPerms, proxy.LocalPerms, proxy.UnSupportPerms} {
+				for method, perm := range perms {
+					if perm != string(apistruct.PermRead) && (co.Route{Pattern: pattern}).Match(method) {
+						return opt, fmt.Errorf("hedge pattern %q matches %s, which requires the %s permission", pattern, method, perm)
+					}
+				}
+			}
+		}
+
+		if cfg.Percentile < 0 || cfg.Percentile > 1 {
+			return opt, fmt.Errorf("hedge percentile %v out of range [0, 1]", cfg.Percentile)
+		}
+
+		if cfg.Percentile > 0 {
+			opt.Percentile = cfg.Percentile
+		}
+
+		if cfg.MinDelay > 0 {
+			opt.MinDelay = time.Duration(cfg.MinDelay)
+		}
+
+		if cfg.MaxDelay > 0 {
+			opt.MaxDelay = time.Duration(cfg.MaxDelay)
+		}
+
+		if opt.MinDelay > opt.MaxDelay {
+			return opt, fmt.Errorf("hedge min delay %s is larger than max delay %s", opt.MinDelay, opt.MaxDelay)
+		}
+
+		return opt, nil
+	})
+}
+
+// ParseTimeoutOption is provided to the higher-level
+func ParseTimeoutOption(cfg TimeoutConfig) dix.Option {
+	return dix.Override(new(co.TimeoutOption), func() (co.TimeoutOption, error) {
+		opt := co.DefaultTimeoutOption()
+		if cfg.Default < 0 {
+			return opt, fmt.Errorf("negative default timeout %s", time.Duration(cfg.Default))
+		}
+
+		opt.Default = time.Duration(cfg.Default)
+		opt.Methods = make(map[string]time.Duration, len(cfg.Methods))
+		for pattern, timeout := range cfg.Methods {
+			if strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+				return opt, fmt.Errorf("invalid timeout pattern %q, wildcard is only allowed at the end", pattern)
+			}
+
+			if timeout < 0 {
+				return opt, fmt.Errorf("negative timeout %s for %s", time.Duration(timeout), pattern)
+			}
+
+			opt.Methods[pattern] = time.Duration(timeout)
+		}
+
+		return opt, nil
+	})
+}
+
+// ParseWalletOption is provided to the higher-level
+func ParseWalletOption(cfg WalletConfig) dix.Option {
+	return dix.Override(new(co.WalletOption), func() co.WalletOption {
+		opt := co.DefaultWalletOption()
+		opt.Signer = cfg.Signer
+		opt.Remote = cfg.Remote
+		return opt
+	})
+}
+
+// ParseNetworkOption is provided to the higher-level, empty values will be taken from the first connected node
+func ParseNetworkOption(genesis string, name string) dix.Option {
+	return dix.Override(new(co.NetworkOption), func() (co.NetworkOption, error) {
+		opt := co.DefaultNetworkOption()
+		if genesis != "" {
+			c, err := cid.Decode(genesis)
+			if err != nil {
+				return opt, fmt.Errorf("invalid genesis cid %s: %w", genesis, err)
+			}
+
+			opt.Genesis = c
+		}
+
+		opt.NetworkName = dtypes.NetworkName(name)
+		return opt, nil
+	})
+}
+
+func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, infos co.NodeInfoList, sel *co.Selector, wallet *co.Wallet, healthOpt HealthOption) (*co.Coordinator, error) {
 	nodes := make([]*co.Node, 0, len(infos))
 	allDone := false
 	defer func() {
@@ -71,6 +285,9 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, inf
 	var head *types.TipSet
 	weight := types.NewInt(0)
 
+	// nodes failed at startup will be retried in background
+	retries := make([]co.NodeInfo, 0, len(infos))
+
 	for i := range infos {
 		info := infos[i]
 		nlog := log.With("host", info.Host)
@@ -78,14 +295,21 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, inf
 		node, err := connector.Connect(info)
 		if err != nil {
 			nlog.Errorf("connect failed: %s", err)
+			retries = append(retries, info)
 			continue
 		}
 
-		full := node.FullNode()
-		h, w, err := getHeadCandidate(full)
+		// nodes without the notify role won't affect the head
+		if !info.Roles.Has(co.RoleNotify) {
+			nodes = append(nodes, node)
+			continue
+		}
+
+		h, w, err := getHeadCandidate(node.FullNode(), node.Option().APITimeout)
 		if err != nil {
 			node.Stop()
 			nlog.Errorf("failed to get head: %s", err)
+			retries = append(retries, info)
 			continue
 		}
 
@@ -98,10 +322,10 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, inf
 	}
 
 	if len(nodes) == 0 {
-		return nil, fmt.Errorf("no available node")
+		log.Warn("no available node, start in degraded mode, the head will be initialized by the first connected node")
 	}
 
-	coordinator, err := co.NewCoordinator(ctx, head, weight, sel)
+	coordinator, err := co.NewCoordinator(ctx, head, weight, sel, wallet, healthOpt.MaxHeadDrift)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +334,11 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, inf
 		OnStart: func(context.Context) error {
 			go coordinator.Start()
 			sel.ReplaceNodes(nodes, nil, false)
+			for i := range retries {
+				connector.Dial(retries[i], func(node *co.Node) {
+					sel.ReplaceNodes([]*co.Node{node}, nil, false)
+				})
+			}
 			return nil
 		},
 		OnStop: func(context.Context) error {
@@ -122,8 +351,8 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, connector *co.Connector, inf
 	return coordinator, nil
 }
 
-func getHeadCandidate(full api.FullNode) (*types.TipSet, types.BigInt, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func getHeadCandidate(full api.FullNode, timeout time.Duration) (*types.TipSet, types.BigInt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	head, err := full.ChainHead(ctx)
@@ -139,31 +368,58 @@ func getHeadCandidate(full api.FullNode) (*types.TipSet, types.BigInt, error) {
 	return head, weight, nil
 }
 
-func buildProxyAPI(sel *co.Selector) *proxy.Proxy {
+func buildProxyAPI(router *co.Router) *proxy.Proxy {
+	fallback := co.Route{Policy: co.RouteRole, Role: co.RoleRead}
 	return &proxy.Proxy{
-		Select: func() (proxy.ProxyAPI, error) {
-			node, err := sel.Select()
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.ProxyAPI, error) {
+			return router.Select(ctx, method, args, fallback)
+		},
+		Wrap: wrapCall(router, fallback, false),
+	}
+}
+
+func buildWriteProxyAPI(router *co.Router, coordinator *co.Coordinator) *proxy.WriteProxy {
+	fallback := co.Route{Policy: co.RouteRole, Role: co.RoleWrite}
+	return &proxy.WriteProxy{
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.WriteProxyAPI, error) {
+			full, err := router.Select(ctx, method, args, fallback)
 			if err != nil {
 				return nil, err
 			}
 
-			return node.FullNode(), nil
+			return coordinator.TrackNonces(full), nil
 		},
+		Wrap: wrapCall(router, fallback, false),
 	}
 }
 
-func buildLocalAPI(lsrv LocalChainService) *proxy.Local {
+func buildLocalAPI(lsrv LocalChainService, router *co.Router) *proxy.Local {
 	return &proxy.Local{
-		Select: func() (proxy.LocalAPI, error) {
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.LocalAPI, error) {
+			// prefix routes won't bypass the coordinator, local methods are only proxied if listed by name
+			if route, ok := router.MatchExact(method); ok {
+				return router.Select(ctx, method, args, route)
+			}
+
 			return &lsrv, nil
 		},
+		Wrap: wrapCall(router, co.Route{}, true),
 	}
 }
 
-func buildUnSupportAPI() *proxy.UnSupport {
+func buildUnSupportAPI(router *co.Router) *proxy.UnSupport {
+	fallback := co.Route{Policy: co.RouteReject}
 	return &proxy.UnSupport{
-		Select: func() (proxy.UnSupportAPI, error) {
-			return nil, fmt.Errorf("api not supported")
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.UnSupportAPI, error) {
+			// admin methods are never unlocked by the prefix routes
+			if proxy.UnSupportPerms[method] == string(apistruct.PermAdmin) {
+				if _, ok := router.MatchExact(method); !ok {
+					return nil, co.ErrRouteRejected
+				}
+			}
+
+			return router.Select(ctx, method, args, fallback)
 		},
+		Wrap: wrapCall(router, fallback, false),
 	}
 }
@@ -0,0 +1,281 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/chain-ro/service"
+	"github.com/dtynn/chain-co/co"
+	"github.com/dtynn/chain-co/dep"
+	"github.com/dtynn/chain-co/fakenode"
+)
+
+const waitTimeout = 10 * time.Second
+
+func newChain(t *testing.T) *fakenode.Chain {
+	chain, err := fakenode.NewChain(20)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	return chain
+}
+
+func extend(t *testing.T, chain *fakenode.Chain, parent *types.TipSet, branch uint64, n int, weightPerEpoch int64) *types.TipSet {
+	ts, err := chain.Extend(parent, branch, n, weightPerEpoch)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	return ts
+}
+
+func startNodes(t *testing.T, chain *fakenode.Chain, heads ...*types.TipSet) []*fakenode.Node {
+	nodes := make([]*fakenode.Node, 0, len(heads))
+	for _, head := range heads {
+		node := fakenode.New(chain, head)
+		t.Cleanup(node.Close)
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func startService(t *testing.T, nodes ...*fakenode.Node) (api.FullNode, service.AdminAPI) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	raws := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		raws = append(raws, node.APIInfo())
+	}
+
+	var full api.FullNode
+	var admin service.AdminAPI
+
+	stop, err := service.Build(
+		ctx,
+
+		dep.MetricsCtxOption(ctx, "chain-co-test"),
+
+		service.ParseNodeInfoList(raws, nil),
+		service.FullNode(&full),
+		service.Admin(&admin),
+	)
+	if err != nil {
+		cancel()
+		t.Fatalf("build service: %s", err)
+	}
+
+	// registered after the nodes, so that the service is stopped before the nodes are closed
+	t.Cleanup(func() {
+		stop(context.Background())
+		cancel()
+	})
+
+	return full, admin
+}
+
+func subscribe(t *testing.T, full api.FullNode) <-chan []*api.HeadChange {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ch, err := full.ChainNotify(ctx)
+	if err != nil {
+		t.Fatalf("call ChainNotify: %s", err)
+	}
+
+	return ch
+}
+
+// nextChanges waits for the next batch of head changes
+func nextChanges(t *testing.T, ch <-chan []*api.HeadChange) []*api.HeadChange {
+	select {
+	case changes, ok := <-ch:
+		if !ok {
+			t.Fatal("ChainNotify channel closed")
+		}
+
+		return changes
+
+	case <-time.After(waitTimeout):
+		t.Fatal("timeout waiting for head changes")
+	}
+
+	return nil
+}
+
+// hostOf returns the host of the node, which is used to identify the node in the fork list
+func hostOf(t *testing.T, node *fakenode.Node) string {
+	info, err := co.ParseNodeInfo(node.APIInfo())
+	if err != nil {
+		t.Fatalf("parse node info: %s", err)
+	}
+
+	return info.Host
+}
+
+func waitForks(t *testing.T, admin service.AdminAPI, cond func([]co.ForkInfo) bool) []co.ForkInfo {
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		forks, err := admin.ListForks(context.Background())
+		if err != nil {
+			t.Fatalf("call ListForks: %s", err)
+		}
+
+		if cond(forks) {
+			return forks
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for forks, got %d", len(forks))
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestHeaviestHeadSelected(t *testing.T) {
+	chain := newChain(t)
+	light := extend(t, chain, chain.Genesis(), 0, 10, 1)
+	heavy := extend(t, chain, chain.Genesis(), 1, 8, 2)
+	behind := extend(t, chain, chain.Genesis(), 1, 4, 2)
+
+	nodes := startNodes(t, chain, light, heavy, behind)
+	full, admin := startService(t, nodes...)
+
+	current := nextChanges(t, subscribe(t, full))
+	if len(current) != 1 || current[0].Type != store.HCCurrent {
+		t.Fatalf("expected a single current change, got %d", len(current))
+	}
+
+	if !current[0].Val.Equals(heavy) {
+		t.Fatalf("expected the heaviest head at %d, got %d", heavy.Height(), current[0].Val.Height())
+	}
+
+	// the node on the lighter branch is reported, the one just behind is not
+	forks := waitForks(t, admin, func(forks []co.ForkInfo) bool {
+		return len(forks) > 0
+	})
+
+	if len(forks) != 1 || forks[0].Node != hostOf(t, nodes[0]) {
+		t.Fatalf("expected only %s to be forked, got %v", hostOf(t, nodes[0]), forks)
+	}
+
+	if forks[0].ForkHeight != 0 || forks[0].BestHead != heavy.Key() {
+		t.Fatalf("unexpected fork info: %+v", forks[0])
+	}
+}
+
+func TestReorgToHeavierFork(t *testing.T) {
+	chain := newChain(t)
+	base := extend(t, chain, chain.Genesis(), 0, 5, 1)
+	head := extend(t, chain, base, 0, 5, 1)
+
+	nodes := startNodes(t, chain, head, head)
+	full, admin := startService(t, nodes...)
+
+	ch := subscribe(t, full)
+	if current := nextChanges(t, ch); !current[0].Val.Equals(head) {
+		t.Fatalf("expected head at %d, got %d", head.Height(), current[0].Val.Height())
+	}
+
+	fork := extend(t, chain, base, 1, 6, 2)
+	if err := nodes[1].SetHead(fork); err != nil {
+		t.Fatalf("set head: %s", err)
+	}
+
+	var reverts, applies []*types.TipSet
+	for len(applies) == 0 || !applies[len(applies)-1].Equals(fork) {
+		for _, hc := range nextChanges(t, ch) {
+			switch hc.Type {
+			case store.HCRevert:
+				if len(applies) > 0 {
+					t.Fatalf("revert at %d after applies", hc.Val.Height())
+				}
+
+				reverts = append(reverts, hc.Val)
+
+			case store.HCApply:
+				applies = append(applies, hc.Val)
+
+			default:
+				t.Fatalf("unexpected change type %s", hc.Type)
+			}
+		}
+	}
+
+	if len(reverts) != 5 || !reverts[0].Equals(head) || reverts[4].Height() != base.Height()+1 {
+		t.Fatalf("expected 5 reverts from %d down to %d, got %d", head.Height(), base.Height()+1, len(reverts))
+	}
+
+	if len(applies) != 6 || applies[0].Height() != base.Height()+1 {
+		t.Fatalf("expected 6 applies from %d, got %d", base.Height()+1, len(applies))
+	}
+
+	for i := 1; i < len(applies); i++ {
+		if applies[i].Parents() != applies[i-1].Key() {
+			t.Fatalf("apply at %d is not on top of the previous one", applies[i].Height())
+		}
+	}
+
+	// the node left on the old branch is now forked, until it follows the reorg.
+	// It doesn't report any new head, so it is detected by the re-check against the new best head.
+	host := hostOf(t, nodes[0])
+	forks := waitForks(t, admin, func(forks []co.ForkInfo) bool {
+		return len(forks) == 1 && forks[0].Node == host
+	})
+
+	if forks[0].Head != head.Key() || forks[0].BestHead != fork.Key() || forks[0].ForkHeight != base.Height() {
+		t.Fatalf("unexpected fork info: %+v", forks[0])
+	}
+
+	if err := nodes[0].SetHead(fork); err != nil {
+		t.Fatalf("set head: %s", err)
+	}
+
+	waitForks(t, admin, func(forks []co.ForkInfo) bool {
+		return len(forks) == 0
+	})
+}
+
+func TestLighterForkIgnored(t *testing.T) {
+	chain := newChain(t)
+	base := extend(t, chain, chain.Genesis(), 0, 5, 2)
+	head := extend(t, chain, base, 0, 5, 2)
+
+	nodes := startNodes(t, chain, head, head)
+	full, admin := startService(t, nodes...)
+
+	ch := subscribe(t, full)
+	nextChanges(t, ch)
+
+	lighter := extend(t, chain, base, 1, 8, 1)
+	if err := nodes[1].SetHead(lighter); err != nil {
+		t.Fatalf("set head: %s", err)
+	}
+
+	forks := waitForks(t, admin, func(forks []co.ForkInfo) bool {
+		return len(forks) > 0
+	})
+
+	if len(forks) != 1 || forks[0].Node != hostOf(t, nodes[1]) || forks[0].Head != lighter.Key() {
+		t.Fatalf("expected %s to be forked at %d, got %v", hostOf(t, nodes[1]), lighter.Height(), forks)
+	}
+
+	if forks[0].ForkHeight != base.Height() || forks[0].BestHead != head.Key() {
+		t.Fatalf("unexpected fork info: %+v", forks[0])
+	}
+
+	select {
+	case changes := <-ch:
+		t.Fatalf("expected no head change for a lighter fork, got %d", len(changes))
+
+	default:
+
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/dtynn/chain-co/co"
+	"github.com/dtynn/chain-co/metrics"
+)
+
+// wrapCall returns the Wrap hook of the proxies, the upstream call is made through the router,
+// which applies the configured timeout and hedging of the method. The duration of each call is recorded.
+// Local methods are served by chain-co itself, they only go through the router if routed upstream by name,
+// the fallback route is not used for them.
+func wrapCall(router *co.Router, fallback co.Route, local bool) func(context.Context, string, []interface{}, func(context.Context) (func(), error)) error {
+	return func(ctx context.Context, method string, args []interface{}, call func(context.Context) (func(), error)) error {
+		route := fallback
+		if local {
+			exact, ok := router.MatchExact(method)
+			if !ok {
+				return observeCall(ctx, method, args, func(ctx context.Context) error {
+					commit, err := call(ctx)
+					if commit != nil {
+						commit()
+					}
+
+					return err
+				})
+			}
+
+			route = exact
+		}
+
+		return observeCall(ctx, method, args, func(ctx context.Context) error {
+			return router.Call(ctx, method, args, route, call)
+		})
+	}
+}
+
+// observeCall records the duration of each call
+func observeCall(ctx context.Context, method string, args []interface{}, call func(context.Context) error) error {
+	start := time.Now()
+	err := call(ctx)
+
+	result := "ok"
+	if err != nil {
+		result = "failed"
+	}
+
+	mctx, _ := tag.New(ctx, tag.Upsert(metrics.Method, method), tag.Upsert(metrics.Result, result))
+	stats.Record(mctx, metrics.APIRequestDuration.M(float64(time.Since(start).Nanoseconds())/1e6))
+
+	return err
+}
@@ -14,6 +14,7 @@ var log = logging.Logger("chain-ro-srv")
 type LocalChainService struct {
 	fx.In
 	*co.Coordinator
+	*co.Wallet
 }
 
 // Service impls api.FullNode
@@ -21,6 +22,7 @@ type Service struct {
 	fx.In
 
 	*proxy.Proxy
+	*proxy.WriteProxy
 	*proxy.Local
 	*proxy.UnSupport
 }
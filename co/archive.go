@@ -0,0 +1,118 @@
+package co
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// DefaultArchiveOption returns default options
+func DefaultArchiveOption() ArchiveOption {
+	return ArchiveOption{
+		Horizon: 2880,
+	}
+}
+
+// ArchiveOption is for routing the deep-history requests
+type ArchiveOption struct {
+	// Horizon is the distance from the best head, requests for the older epochs will be routed to the archive nodes.
+	// Zero means disabled.
+	Horizon abi.ChainEpoch
+}
+
+// methods that may query the deep history, in addition to State*
+var archiveMethods = map[string]bool{
+	"ChainGetTipSetByHeight": true,
+	"ChainGetParentReceipts": true,
+	"ChainGetParentMessages": true,
+	"ChainGetBlockMessages":  true,
+}
+
+// positions of the epoch params which are the heights being queried, other epoch params, like the
+// lookback limits of StateSearchMsgLimited and StateAllMinerFaults, are not heights. The params are
+// counted without the context.
+var epochParams = map[string]int{
+	"ChainGetTipSetByHeight": 0,
+	"StateCompute":           0,
+	"StateListMessages":      2,
+}
+
+// methods whose cid params are block cids
+var blockCidMethods = map[string]bool{
+	"ChainGetParentReceipts": true,
+	"ChainGetParentMessages": true,
+	"ChainGetBlockMessages":  true,
+}
+
+func isArchiveMethod(method string) bool {
+	return archiveMethods[method] || strings.HasPrefix(method, "State")
+}
+
+// selectArchive chooses an archive node if the request is querying the epoch beyond the horizon,
+// nil if the request should be handled by the normal selection
+func (r *Router) selectArchive(ctx context.Context, method string, archives []*Node, args []interface{}) *Node {
+	head := r.coordinator.Head()
+	if head == nil || head.Height() <= r.archiveOpt.Horizon {
+		return nil
+	}
+
+	archive := pickWeighted(archives)
+
+	epoch, ok := r.requestEpoch(ctx, method, archive, args)
+	if !ok || epoch >= head.Height()-r.archiveOpt.Horizon {
+		return nil
+	}
+
+	archive.log.Debugw("route to archive node", "method", method, "epoch", epoch, "head", head.Height())
+	return archive
+}
+
+// requestEpoch finds out the epoch that the request is querying, false if unknown.
+// The height param is used if the method has one, otherwise the height of the tipset key
+// or the block cid in the params.
+func (r *Router) requestEpoch(ctx context.Context, method string, archive *Node, args []interface{}) (abi.ChainEpoch, bool) {
+	if idx, ok := epochParams[method]; ok && idx < len(args) {
+		if epoch, ok := args[idx].(abi.ChainEpoch); ok {
+			return epoch, true
+		}
+	}
+
+	var blkCid cid.Cid
+
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case types.TipSetKey:
+			if cids := v.Cids(); len(cids) > 0 {
+				blkCid = cids[0]
+			}
+
+		case cid.Cid:
+			if blockCidMethods[method] {
+				blkCid = v
+			}
+		}
+
+		if blkCid.Defined() {
+			break
+		}
+	}
+
+	if !blkCid.Defined() {
+		return 0, false
+	}
+
+	loadCtx, loadCancel := context.WithTimeout(ctx, archive.opt.APITimeout)
+	defer loadCancel()
+
+	blk, err := archive.loadBlockHeader(loadCtx, blkCid)
+	if err != nil {
+		archive.log.Warnf("load block header %s for %s: %s", blkCid, method, err)
+		return 0, false
+	}
+
+	return blk.Height, true
+}
@@ -0,0 +1,89 @@
+package co
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+
+	"github.com/dtynn/chain-co/fakenode"
+)
+
+func TestRouterSelectArchive(t *testing.T) {
+	chain, err := fakenode.NewChain(3000)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	old, err := chain.Extend(chain.Genesis(), 0, 50, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	head, err := chain.Extend(old, 0, 2950, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	bcache, err := newBlockHeaderCache(16)
+	if err != nil {
+		t.Fatalf("construct block header cache: %s", err)
+	}
+
+	reader, _ := newMockNode("reader", RoleRead)
+	archive, amock := newMockNode("archive", RoleArchive)
+	archive.sctx = &Ctx{bcache: bcache}
+	amock.ChainGetBlockFunc = func(_ context.Context, bcid cid.Cid) (*types.BlockHeader, error) {
+		return chain.Block(bcid)
+	}
+
+	sel := newMockSelector(t, []*Node{reader, archive})
+	weight, _ := chain.Weight(head.Key())
+	coordinator, err := NewCoordinator(&Ctx{}, head, weight, sel, nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	router, err := NewRouter(sel, coordinator, RouteTable{{Pattern: "*", Policy: RouteRole}}, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	msg := old.Blocks()[0].Messages
+
+	cases := []struct {
+		name    string
+		method  string
+		args    []interface{}
+		archive bool
+	}{
+		{"old height", "ChainGetTipSetByHeight", []interface{}{abi.ChainEpoch(10), types.EmptyTSK}, true},
+		{"recent height", "ChainGetTipSetByHeight", []interface{}{abi.ChainEpoch(2990), types.EmptyTSK}, false},
+		{"old tipset", "StateGetActor", []interface{}{address.Undef, old.Key()}, true},
+		{"head tipset", "StateGetActor", []interface{}{address.Undef, head.Key()}, false},
+		{"empty tipset key", "StateGetActor", []interface{}{address.Undef, types.EmptyTSK}, false},
+		// the lookback is not a height, the tipset key tells the epoch
+		{"lookback with head tipset", "StateAllMinerFaults", []interface{}{abi.ChainEpoch(5), head.Key()}, false},
+		{"lookback with old tipset", "StateAllMinerFaults", []interface{}{abi.ChainEpoch(5), old.Key()}, true},
+		{"search limit", "StateSearchMsgLimited", []interface{}{msg, abi.ChainEpoch(5)}, false},
+		{"wait limit", "StateWaitMsgLimited", []interface{}{msg, uint64(5), abi.ChainEpoch(5)}, false},
+		{"old lowest height", "StateListMessages", []interface{}{nil, head.Key(), abi.ChainEpoch(20)}, true},
+		{"old block", "ChainGetBlockMessages", []interface{}{old.Cids()[0]}, true},
+		{"not a block cid", "ChainGetMessage", []interface{}{old.Cids()[0]}, false},
+	}
+
+	for _, c := range cases {
+		full, err := router.Select(context.Background(), c.method, c.args, Route{Policy: RouteReject})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if toArchive := full == archive.FullNode(); toArchive != c.archive {
+			t.Errorf("%s: expected routed to the archive node %v, got %v", c.name, c.archive, toArchive)
+		}
+	}
+}
@@ -16,6 +16,14 @@ func (c *Coordinator) ChainNotify(ctx context.Context) (<-chan []*api.HeadChange
 	head := c.head
 	c.headMu.RUnlock()
 
+	if head == nil {
+		c.tspub.Unsub(subch)
+		for range subch {
+		}
+
+		return nil, ErrHeadNotReady
+	}
+
 	out := make(chan []*api.HeadChange, 32)
 	out <- []*api.HeadChange{{
 		Type: store.HCCurrent,
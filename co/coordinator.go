@@ -16,6 +16,9 @@ import (
 // common errors
 var (
 	ErrNoNodeAvailable = fmt.Errorf("no node available")
+	ErrNetworkMismatch = fmt.Errorf("network mismatch")
+	ErrHeadNotReady    = fmt.Errorf("chain head not ready")
+	ErrNoNodeOnTipSet  = fmt.Errorf("no node on the tipset")
 )
 
 var log = logging.Logger("chain-co")
@@ -24,16 +27,26 @@ const (
 	tipsetChangeTopic = "tschange"
 )
 
-// NewCoordinator constructs a Coordinator instance
-func NewCoordinator(ctx *Ctx, head *types.TipSet, weight types.BigInt, sel *Selector) (*Coordinator, error) {
-	return &Coordinator{
-		ctx:    ctx,
-		head:   head,
-		weight: weight,
-		nodes:  make([]string, 0, 16),
-		sel:    sel,
-		tspub:  pubsub.New(256),
-	}, nil
+// NewCoordinator constructs a Coordinator instance, the head can be nil and will be initialized
+// from the first node that reports one.
+// The best head is treated as stale in SyncState if it is older than maxHeadDrift, zero means never.
+func NewCoordinator(ctx *Ctx, head *types.TipSet, weight types.BigInt, sel *Selector, wallet *Wallet, maxHeadDrift time.Duration) (*Coordinator, error) {
+	c := &Coordinator{
+		ctx:          ctx,
+		head:         head,
+		weight:       weight,
+		nodes:        make([]string, 0, 16),
+		sel:          sel,
+		wallet:       wallet,
+		maxHeadDrift: maxHeadDrift,
+		tspub:        pubsub.New(256),
+		forkCh:       make(chan *forkCheck, 64),
+	}
+
+	c.forks.infos = map[string]*ForkInfo{}
+	c.forks.seen = map[string]*headCandidate{}
+
+	return c, nil
 }
 
 // Coordinator tries to setup the best nodes based on their incoming chain head
@@ -47,7 +60,18 @@ type Coordinator struct {
 
 	sel *Selector
 
+	wallet *Wallet
+
+	maxHeadDrift time.Duration
+
 	tspub *pubsub.PubSub
+
+	forkCh chan *forkCheck
+	forks  struct {
+		sync.RWMutex
+		infos map[string]*ForkInfo
+		seen  map[string]*headCandidate
+	}
 }
 
 // Start starts the coordinate loop
@@ -55,6 +79,8 @@ func (c *Coordinator) Start() {
 	log.Info("start head coordinator loop")
 	defer log.Info("stop head coordinator loop")
 
+	go c.forkLoop()
+
 	for {
 		select {
 		case <-c.ctx.lc.Done():
@@ -66,15 +92,26 @@ func (c *Coordinator) Start() {
 	}
 }
 
+// Head returns the current best head, nil if not initialized yet
+func (c *Coordinator) Head() *types.TipSet {
+	c.headMu.RLock()
+	defer c.headMu.RUnlock()
+	return c.head
+}
+
 // Stop shuts down the included components
 func (c *Coordinator) Stop() error {
 	c.tspub.Shutdown()
+	c.ctx.mpool.pub.Shutdown()
 	return nil
 }
 
 func (c *Coordinator) handleCandidate(hc *headCandidate) {
 	clog := log.With("node", hc.node.info.Host, "h", hc.ts.Height(), "w", hc.weight, "drift", time.Now().Unix()-int64(hc.ts.MinTimestamp()))
 
+	addr := hc.node.info.Addr
+	c.markSeen(addr, hc)
+
 	c.headMu.Lock()
 
 	if c.head == nil || hc.weight.GreaterThan(c.weight) {
@@ -85,43 +122,68 @@ func (c *Coordinator) handleCandidate(hc *headCandidate) {
 
 		c.head = hc.ts
 		c.weight = hc.weight
-		c.nodes = append(c.nodes[:0], hc.node.info.Addr)
-		c.sel.setPriors(hc.node.info.Addr)
+		c.nodes = append(c.nodes[:0], addr)
+		c.sel.setPriors(addr)
 
 		c.headMu.Unlock()
 
+		c.clearFork(addr, next.Key())
+
 		if err := c.applyTipSetChange(prev, next, hc.node); err != nil {
 			clog.Errorf("apply tipset change: %s", err)
 		}
 
+		c.recheckForks(next, addr)
 		return
 	}
 
 	if c.head.Equals(hc.ts) {
 		contains := false
 		for ni := range c.nodes {
-			if c.nodes[ni] == hc.node.info.Addr {
+			if c.nodes[ni] == addr {
 				contains = true
 				break
 			}
 		}
 
 		if !contains {
-			c.nodes = append(c.nodes, hc.node.info.Addr)
+			c.nodes = append(c.nodes, addr)
 			c.sel.setPriors(c.nodes...)
 
 			clog.Debug("another node caught up")
 		}
 
 		c.headMu.Unlock()
+
+		c.clearFork(addr, hc.ts.Key())
 		return
 	}
 
-	clog.Debug("ignored a lighter head")
-	return
+	best := c.head
+	bestNode := ""
+	if len(c.nodes) > 0 {
+		bestNode = c.nodes[0]
+	}
+
+	c.headMu.Unlock()
+
+	clog.Debug("got a lighter head, check if it is on another branch")
+	c.scheduleForkCheck(&forkCheck{
+		node:     hc.node,
+		ts:       hc.ts,
+		weight:   hc.weight,
+		best:     best,
+		bestNode: bestNode,
+	})
 }
 
 func (c *Coordinator) applyTipSetChange(prev, next *types.TipSet, node *Node) error {
+	// there is no subscriber before the head is initialized
+	if prev == nil {
+		log.Infow("head initialized", "h", next.Height())
+		return nil
+	}
+
 	revert, apply, err := store.ReorgOps(node.loadTipSet, prev, next)
 	if err != nil {
 		return err
@@ -135,7 +197,8 @@ func (c *Coordinator) applyTipSetChange(prev, next *types.TipSet, node *Node) er
 		})
 	}
 
-	for i := range apply {
+	// apply is ordered from the new head backwards, subscribers expect ascending heights
+	for i := len(apply) - 1; i >= 0; i-- {
 		hc = append(hc, &api.HeadChange{
 			Type: store.HCApply,
 			Val:  apply[i],
@@ -13,17 +13,26 @@ import (
 )
 
 // NewCtx constructs a Ctx instance
-func NewCtx(mctx helpers.MetricsCtx, lc fx.Lifecycle, nodeOpt NodeOption) (*Ctx, error) {
+func NewCtx(mctx helpers.MetricsCtx, lc fx.Lifecycle, nodeOpt NodeOption, netOpt NetworkOption, gasOpt GasOption, nonceOpt NonceOption) (*Ctx, error) {
 	bcache, err := newBlockHeaderCache(1 << 20)
 	if err != nil {
 		return nil, err
 	}
 
+	mpool, err := newMpoolHub(1 << 16)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Ctx{
 		lc:      helpers.LifecycleCtx(mctx, lc),
 		bcache:  bcache,
 		headCh:  make(chan *headCandidate, 256),
 		nodeOpt: nodeOpt,
+		network: newNetworkGuard(netOpt),
+		gasOpt:  gasOpt,
+		nonces:  newNonceTracker(nonceOpt),
+		mpool:   mpool,
 	}, nil
 }
 
@@ -33,6 +42,10 @@ type Ctx struct {
 	bcache  *blockHeaderCache
 	headCh  chan *headCandidate
 	nodeOpt NodeOption
+	network *networkGuard
+	gasOpt  GasOption
+	nonces  *nonceTracker
+	mpool   *mpoolHub
 }
 
 type headCandidate struct {
@@ -0,0 +1,48 @@
+package co
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// broadcast makes the call on all the given nodes concurrently, each call is pinned to one of the nodes.
+// The results of the first successful call are committed, other calls keep going on in background.
+func broadcast(ctx context.Context, method string, nodes []*Node, call func(context.Context) (func(), error)) error {
+	if len(nodes) == 0 {
+		return ErrNoNodeAvailable
+	}
+
+	type result struct {
+		node   *Node
+		commit func()
+		err    error
+	}
+
+	results := make(chan result, len(nodes))
+	for i := range nodes {
+		go func(node *Node) {
+			commit, err := call(withUpstream(ctx, node))
+			results <- result{
+				node:   node,
+				commit: commit,
+				err:    err,
+			}
+		}(nodes[i])
+	}
+
+	var merr *multierror.Error
+	for range nodes {
+		res := <-results
+		if res.err == nil {
+			res.commit()
+			return nil
+		}
+
+		res.node.log.Warnf("broadcast %s: %s", method, res.err)
+		merr = multierror.Append(merr, fmt.Errorf("%s: %w", res.node.info.Host, res.err))
+	}
+
+	return merr.ErrorOrNil()
+}
@@ -0,0 +1,216 @@
+package co
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/metrics"
+)
+
+// heads lower than this distance from the best head are treated as lagging behind,
+// we won't walk back that far to find out whether they are on the same branch
+const forkCheckMaxDepth = 900
+
+// ForkInfo describes an upstream node whose head is on a different branch from the best head
+type ForkInfo struct {
+	// Node is the host of the upstream node, the same as NodeStatus.Host
+	Node   string
+	Head   types.TipSetKey
+	Height abi.ChainEpoch
+	Weight types.BigInt
+
+	BestHead   types.TipSetKey
+	BestHeight abi.ChainEpoch
+
+	// ForkPoint is the latest common ancestor of the two branches
+	ForkPoint  types.TipSetKey
+	ForkHeight abi.ChainEpoch
+
+	DetectedAt time.Time
+}
+
+type forkCheck struct {
+	node   *Node
+	ts     *types.TipSet
+	weight types.BigInt
+
+	best     *types.TipSet
+	bestNode string
+}
+
+// ListForks returns the upstream nodes that are on a different branch from the best head
+func (c *Coordinator) ListForks(context.Context) ([]ForkInfo, error) {
+	c.forks.RLock()
+	defer c.forks.RUnlock()
+
+	forks := make([]ForkInfo, 0, len(c.forks.infos))
+	for _, fi := range c.forks.infos {
+		forks = append(forks, *fi)
+	}
+
+	sort.Slice(forks, func(i, j int) bool {
+		return forks[i].Node < forks[j].Node
+	})
+
+	return forks, nil
+}
+
+func (c *Coordinator) forkLoop() {
+	for {
+		select {
+		case <-c.ctx.lc.Done():
+			return
+
+		case fc := <-c.forkCh:
+			c.checkFork(fc)
+		}
+	}
+}
+
+func (c *Coordinator) scheduleForkCheck(fc *forkCheck) {
+	select {
+	case c.forkCh <- fc:
+
+	default:
+		fc.node.log.Debugw("fork check queue is full, skipped", "h", fc.ts.Height())
+	}
+}
+
+func (c *Coordinator) checkFork(fc *forkCheck) {
+	addr := fc.node.info.Addr
+	host := fc.node.info.Host
+	flog := fc.node.log.With("h", fc.ts.Height(), "best", fc.best.Height())
+
+	if fc.best.Height()-fc.ts.Height() > forkCheckMaxDepth {
+		flog.Debug("head is too far behind for a fork check")
+		return
+	}
+
+	bestNode, _ := c.sel.node(fc.bestNode)
+	loadTipSet := func(tsk types.TipSetKey) (*types.TipSet, error) {
+		ts, err := fc.node.loadTipSet(tsk)
+		if err == nil || bestNode == nil {
+			return ts, err
+		}
+
+		return bestNode.loadTipSet(tsk)
+	}
+
+	_, apply, err := store.ReorgOps(loadTipSet, fc.best, fc.ts)
+	if err != nil {
+		flog.Warnf("walk back for the fork point: %s", err)
+		return
+	}
+
+	// the head is an ancestor of the best one, the node is just behind
+	if len(apply) == 0 {
+		c.clearFork(addr, fc.ts.Key())
+		return
+	}
+
+	forkPoint, err := loadTipSet(apply[len(apply)-1].Parents())
+	if err != nil {
+		flog.Warnf("load fork point: %s", err)
+		return
+	}
+
+	fi := &ForkInfo{
+		Node:       host,
+		Head:       fc.ts.Key(),
+		Height:     fc.ts.Height(),
+		Weight:     fc.weight,
+		BestHead:   fc.best.Key(),
+		BestHeight: fc.best.Height(),
+		ForkPoint:  forkPoint.Key(),
+		ForkHeight: forkPoint.Height(),
+		DetectedAt: time.Now(),
+	}
+
+	c.forks.Lock()
+	// the node has moved on while we were walking the chain
+	if seen, ok := c.forks.seen[addr]; !ok || seen.ts.Key() != fc.ts.Key() {
+		c.forks.Unlock()
+		return
+	}
+
+	_, existed := c.forks.infos[addr]
+	c.forks.infos[addr] = fi
+	forked := len(c.forks.infos)
+	c.forks.Unlock()
+
+	depth := int64(fc.best.Height() - forkPoint.Height())
+	if !existed {
+		flog.Warnw("upstream fork detected", "fork", forkPoint.Height(), "depth", depth, "weight", fc.weight)
+	}
+
+	mctx, _ := tag.New(c.ctx.lc, tag.Upsert(metrics.Node, host))
+	if !existed {
+		stats.Record(mctx, metrics.ForkDetected.M(1))
+	}
+	stats.Record(mctx, metrics.ForkDepth.M(depth))
+	stats.Record(c.ctx.lc, metrics.ForkedNodes.M(int64(forked)))
+}
+
+// markSeen records the latest head reported by the node, which will be used to drop outdated fork checks,
+// and to re-check the node when the best head moves
+func (c *Coordinator) markSeen(addr string, hc *headCandidate) {
+	c.forks.Lock()
+	c.forks.seen[addr] = hc
+	c.forks.Unlock()
+}
+
+// recheckForks schedules fork checks for the other nodes against the new best head,
+// since the nodes left on the old branch won't report again until their heads change
+func (c *Coordinator) recheckForks(best *types.TipSet, bestNode string) {
+	c.forks.RLock()
+	checks := make([]*forkCheck, 0, len(c.forks.seen))
+	for addr, hc := range c.forks.seen {
+		if addr == bestNode || hc.ts.Equals(best) {
+			continue
+		}
+
+		checks = append(checks, &forkCheck{
+			node:     hc.node,
+			ts:       hc.ts,
+			weight:   hc.weight,
+			best:     best,
+			bestNode: bestNode,
+		})
+	}
+	c.forks.RUnlock()
+
+	for _, fc := range checks {
+		c.scheduleForkCheck(fc)
+	}
+}
+
+func (c *Coordinator) clearFork(addr string, tsk types.TipSetKey) {
+	c.forks.Lock()
+	if seen, ok := c.forks.seen[addr]; ok && seen.ts.Key() != tsk {
+		c.forks.Unlock()
+		return
+	}
+
+	fi, existed := c.forks.infos[addr]
+	delete(c.forks.infos, addr)
+	forked := len(c.forks.infos)
+	c.forks.Unlock()
+
+	if !existed {
+		return
+	}
+
+	log.Infow("upstream fork resolved", "node", fi.Node, "fork", fi.ForkHeight)
+
+	mctx, _ := tag.New(c.ctx.lc, tag.Upsert(metrics.Node, fi.Node))
+	stats.Record(mctx, metrics.ForkDepth.M(0))
+	stats.Record(c.ctx.lc, metrics.ForkedNodes.M(int64(forked)))
+}
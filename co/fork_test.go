@@ -0,0 +1,97 @@
+package co
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+
+	"github.com/dtynn/chain-co/fakenode"
+)
+
+func TestCheckFork(t *testing.T) {
+	chain, err := fakenode.NewChain(10)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	base, err := chain.Extend(chain.Genesis(), 0, 5, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	best, err := chain.Extend(base, 0, 3, 2)
+	if err != nil {
+		t.Fatalf("extend best branch: %s", err)
+	}
+
+	forkHead, err := chain.Extend(base, 1, 3, 1)
+	if err != nil {
+		t.Fatalf("extend fork: %s", err)
+	}
+
+	bcache, err := newBlockHeaderCache(64)
+	if err != nil {
+		t.Fatalf("construct block header cache: %s", err)
+	}
+
+	ctx := &Ctx{lc: context.Background(), bcache: bcache}
+
+	nodes := map[string]*Node{}
+	for _, host := range []string{"best", "fork", "behind"} {
+		node, mock := newMockNode(host, RoleAll)
+		node.sctx = ctx
+		mock.ChainGetBlockFunc = func(_ context.Context, bcid cid.Cid) (*types.BlockHeader, error) {
+			return chain.Block(bcid)
+		}
+
+		nodes[host] = node
+	}
+
+	sel := newMockSelector(t, []*Node{nodes["best"], nodes["fork"], nodes["behind"]}, "best")
+	c, err := NewCoordinator(ctx, best, types.NewInt(0), sel, nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	check := func(host string, ts *types.TipSet) {
+		c.markSeen(host, &headCandidate{node: nodes[host], ts: ts})
+		c.checkFork(&forkCheck{node: nodes[host], ts: ts, best: best, bestNode: "best"})
+	}
+
+	check("fork", forkHead)
+	check("behind", base)
+
+	forks, _ := c.ListForks(context.Background())
+	if len(forks) != 1 {
+		t.Fatalf("expected only the node on the other branch to be forked, got %d", len(forks))
+	}
+
+	if fi := forks[0]; fi.Node != "fork" || fi.Head != forkHead.Key() || fi.ForkPoint != base.Key() || fi.ForkHeight != base.Height() {
+		t.Errorf("expected fork point %d for node fork, got %d for node %s", base.Height(), fi.ForkHeight, fi.Node)
+	}
+
+	// the best head moves, the other nodes are checked again
+	c.recheckForks(best, "best")
+	if queued := len(c.forkCh); queued != 2 {
+		t.Errorf("expected 2 fork checks queued, got %d", queued)
+	}
+
+	for len(c.forkCh) > 0 {
+		<-c.forkCh
+	}
+
+	// the check is dropped after the node has reported another head
+	c.markSeen("fork", &headCandidate{node: nodes["fork"], ts: base})
+	c.checkFork(&forkCheck{node: nodes["fork"], ts: forkHead, best: best, bestNode: "best"})
+	if forks, _ := c.ListForks(context.Background()); len(forks) != 1 || forks[0].Head != forkHead.Key() {
+		t.Error("expected the outdated check not to change the fork")
+	}
+
+	// the node switches to the best branch
+	check("fork", best)
+	if forks, _ := c.ListForks(context.Background()); len(forks) != 0 {
+		t.Errorf("expected the fork to be resolved, got %d forks", len(forks))
+	}
+}
@@ -0,0 +1,295 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/config"
+)
+
+// DefaultGasOption returns default options
+func DefaultGasOption() GasOption {
+	return GasOption{
+		Aggregate:     false,
+		Fanout:        3,
+		DefaultMaxFee: types.BigInt(config.DefaultDefaultMaxFee),
+	}
+}
+
+// GasOption is for gas estimation
+type GasOption struct {
+	// Aggregate enables querying several upstream nodes in parallel and combining the results,
+	// otherwise the estimation is proxied to one node
+	Aggregate bool
+
+	// Fanout is the max number of nodes to query when aggregating
+	Fanout int
+
+	// DefaultMaxFee limits the fee of the aggregated estimation if the MaxFee in the spec is not set,
+	// the same as the DefaultMaxFee in the lotus config
+	DefaultMaxFee types.BigInt
+}
+
+// GasEstimateFeeCap impls api.FullNode.GasEstimateFeeCap, the max fee cap will be used
+func (c *Coordinator) GasEstimateFeeCap(ctx context.Context, msg *types.Message, maxqueueblks int64, tsk types.TipSetKey) (types.BigInt, error) {
+	nodes, err := c.gasNodes()
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	vals := make([]types.BigInt, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "GasEstimateFeeCap", func(ctx context.Context, i int, full api.FullNode) error {
+		val, err := full.GasEstimateFeeCap(ctx, msg, maxqueueblks, tsk)
+		vals[i] = val
+		return err
+	})
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	return maxBigInt(pickOK(vals, oks)), nil
+}
+
+// GasEstimateGasLimit impls api.FullNode.GasEstimateGasLimit, the max gas limit will be used
+func (c *Coordinator) GasEstimateGasLimit(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (int64, error) {
+	nodes, err := c.gasNodes()
+	if err != nil {
+		return 0, err
+	}
+
+	vals := make([]int64, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "GasEstimateGasLimit", func(ctx context.Context, i int, full api.FullNode) error {
+		val, err := full.GasEstimateGasLimit(ctx, msg, tsk)
+		vals[i] = val
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	limit := int64(0)
+	for i := range vals {
+		if oks[i] && vals[i] > limit {
+			limit = vals[i]
+		}
+	}
+
+	return limit, nil
+}
+
+// GasEstimateGasPremium impls api.FullNode.GasEstimateGasPremium, the median premium will be used
+func (c *Coordinator) GasEstimateGasPremium(ctx context.Context, nblocksincl uint64, sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error) {
+	nodes, err := c.gasNodes()
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	vals := make([]types.BigInt, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "GasEstimateGasPremium", func(ctx context.Context, i int, full api.FullNode) error {
+		val, err := full.GasEstimateGasPremium(ctx, nblocksincl, sender, gaslimit, tsk)
+		vals[i] = val
+		return err
+	})
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	return medianBigInt(pickOK(vals, oks)), nil
+}
+
+// GasEstimateMessageGas impls api.FullNode.GasEstimateMessageGas.
+// The estimated messages are combined with the max gas limit, the max fee cap and the median premium,
+// then the fee cap is limited by the MaxFee in the spec, or the DefaultMaxFee if it's not set.
+func (c *Coordinator) GasEstimateMessageGas(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec, tsk types.TipSetKey) (*types.Message, error) {
+	nodes, err := c.gasNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0].FullNode().GasEstimateMessageGas(ctx, msg, spec, tsk)
+	}
+
+	msgs := make([]*types.Message, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "GasEstimateMessageGas", func(ctx context.Context, i int, full api.FullNode) error {
+		estimated, err := full.GasEstimateMessageGas(ctx, msg, spec, tsk)
+		msgs[i] = estimated
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs = pickOKMessages(msgs, oks)
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no estimated message returned")
+	}
+
+	limits := make([]int64, 0, len(msgs))
+	feeCaps := make([]types.BigInt, 0, len(msgs))
+	premiums := make([]types.BigInt, 0, len(msgs))
+	for _, m := range msgs {
+		limits = append(limits, m.GasLimit)
+		feeCaps = append(feeCaps, m.GasFeeCap)
+		premiums = append(premiums, m.GasPremium)
+	}
+
+	out := *msgs[0]
+	out.GasLimit = 0
+	for _, l := range limits {
+		if l > out.GasLimit {
+			out.GasLimit = l
+		}
+	}
+
+	out.GasFeeCap = maxBigInt(feeCaps)
+	out.GasPremium = medianBigInt(premiums)
+
+	maxFee := c.ctx.gasOpt.DefaultMaxFee
+	if spec != nil && spec.MaxFee.Int != nil && !spec.MaxFee.IsZero() {
+		maxFee = spec.MaxFee
+	}
+
+	capGasFee(&out, maxFee)
+
+	return &out, nil
+}
+
+// gasNodes returns the nodes to query for gas estimation
+func (c *Coordinator) gasNodes() ([]*Node, error) {
+	opt := c.ctx.gasOpt
+	if !opt.Aggregate || opt.Fanout <= 1 {
+		node, err := c.sel.Select(RoleRead)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Node{node}, nil
+	}
+
+	nodes := c.sel.candidates(RoleRead, true)
+	if len(nodes) == 0 {
+		nodes = c.sel.Candidates(RoleRead)
+	}
+
+	if len(nodes) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+
+	rand.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+
+	if len(nodes) > opt.Fanout {
+		nodes = nodes[:opt.Fanout]
+	}
+
+	return nodes, nil
+}
+
+// queryNodes calls the given func for each node concurrently,
+// and returns the successful flags for the nodes, an error will be returned if all the calls fail
+func queryNodes(ctx context.Context, nodes []*Node, method string, call func(ctx context.Context, i int, full api.FullNode) error) ([]bool, error) {
+	oks := make([]bool, len(nodes))
+	errs := make([]error, len(nodes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i := range nodes {
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = call(ctx, i, nodes[i].FullNode())
+		}(i)
+	}
+
+	wg.Wait()
+
+	var merr *multierror.Error
+	succeeded := 0
+	for i := range errs {
+		if errs[i] != nil {
+			nodes[i].log.Warnf("call %s: %s", method, errs[i])
+			merr = multierror.Append(merr, fmt.Errorf("%s: %w", nodes[i].info.Host, errs[i]))
+			continue
+		}
+
+		oks[i] = true
+		succeeded++
+	}
+
+	if succeeded == 0 {
+		return nil, merr.ErrorOrNil()
+	}
+
+	return oks, nil
+}
+
+func pickOK(vals []types.BigInt, oks []bool) []types.BigInt {
+	picked := make([]types.BigInt, 0, len(vals))
+	for i := range vals {
+		if oks[i] {
+			picked = append(picked, vals[i])
+		}
+	}
+
+	return picked
+}
+
+func pickOKMessages(msgs []*types.Message, oks []bool) []*types.Message {
+	picked := make([]*types.Message, 0, len(msgs))
+	for i := range msgs {
+		if oks[i] && msgs[i] != nil {
+			picked = append(picked, msgs[i])
+		}
+	}
+
+	return picked
+}
+
+func maxBigInt(vals []types.BigInt) types.BigInt {
+	max := big.Zero()
+	for _, v := range vals {
+		if v.GreaterThan(max) {
+			max = v
+		}
+	}
+
+	return max
+}
+
+func medianBigInt(vals []types.BigInt) types.BigInt {
+	if len(vals) == 0 {
+		return big.Zero()
+	}
+
+	sorted := append([]types.BigInt(nil), vals...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+
+	return sorted[len(sorted)/2]
+}
+
+// capGasFee limits the total fee of the message within maxFee, same as what lotus does
+func capGasFee(msg *types.Message, maxFee types.BigInt) {
+	if maxFee.Int == nil || maxFee.IsZero() || msg.GasLimit <= 0 {
+		return
+	}
+
+	gl := types.NewInt(uint64(msg.GasLimit))
+	if big.Mul(msg.GasFeeCap, gl).LessThanEqual(maxFee) {
+		return
+	}
+
+	msg.GasFeeCap = big.Div(maxFee, gl)
+	msg.GasPremium = big.Min(msg.GasFeeCap, msg.GasPremium)
+}
@@ -0,0 +1,162 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+func ints(vals ...uint64) []types.BigInt {
+	bis := make([]types.BigInt, 0, len(vals))
+	for _, v := range vals {
+		bis = append(bis, types.NewInt(v))
+	}
+
+	return bis
+}
+
+func TestMaxBigInt(t *testing.T) {
+	cases := []struct {
+		vals []types.BigInt
+		max  uint64
+	}{
+		{nil, 0},
+		{ints(7), 7},
+		{ints(3, 9, 1), 9},
+		{ints(5, 5, 2), 5},
+	}
+
+	for _, c := range cases {
+		if max := maxBigInt(c.vals); !max.Equals(types.NewInt(c.max)) {
+			t.Errorf("%v: expected %d, got %s", c.vals, c.max, max)
+		}
+	}
+}
+
+func TestMedianBigInt(t *testing.T) {
+	cases := []struct {
+		vals   []types.BigInt
+		median uint64
+	}{
+		{nil, 0},
+		{ints(7), 7},
+		{ints(9, 1, 5), 5},
+		// the upper one of the middle pair is taken for even counts
+		{ints(4, 1, 3, 2), 3},
+		{ints(2, 2, 8, 8), 8},
+	}
+
+	for _, c := range cases {
+		if median := medianBigInt(c.vals); !median.Equals(types.NewInt(c.median)) {
+			t.Errorf("%v: expected %d, got %s", c.vals, c.median, median)
+		}
+	}
+
+	vals := ints(3, 1, 2)
+	medianBigInt(vals)
+	if !vals[0].Equals(types.NewInt(3)) || !vals[1].Equals(types.NewInt(1)) {
+		t.Errorf("expected the input to be left unsorted, got %v", vals)
+	}
+}
+
+func TestPickOK(t *testing.T) {
+	picked := pickOK(ints(1, 2, 3), []bool{true, false, true})
+	if len(picked) != 2 || !picked[0].Equals(types.NewInt(1)) || !picked[1].Equals(types.NewInt(3)) {
+		t.Errorf("unexpected picked values %v", picked)
+	}
+
+	msg := &types.Message{Nonce: 1}
+	msgs := pickOKMessages([]*types.Message{nil, msg, {Nonce: 2}}, []bool{true, true, false})
+	if len(msgs) != 1 || msgs[0] != msg {
+		t.Errorf("unexpected picked messages %v", msgs)
+	}
+}
+
+func TestCapGasFee(t *testing.T) {
+	cases := []struct {
+		name     string
+		gasLimit int64
+		feeCap   uint64
+		premium  uint64
+		maxFee   types.BigInt
+		expCap   uint64
+		expPrem  uint64
+	}{
+		{"no max fee", 100, 10, 5, types.BigInt{}, 10, 5},
+		{"zero max fee", 100, 10, 5, types.NewInt(0), 10, 5},
+		{"no gas limit", 0, 10, 5, types.NewInt(1), 10, 5},
+		{"within max fee", 100, 10, 5, types.NewInt(1000), 10, 5},
+		{"capped fee cap", 100, 10, 5, types.NewInt(800), 8, 5},
+		{"capped premium", 100, 10, 5, types.NewInt(300), 3, 3},
+		{"rounded down", 100, 10, 5, types.NewInt(799), 7, 5},
+	}
+
+	for _, c := range cases {
+		msg := &types.Message{
+			GasLimit:   c.gasLimit,
+			GasFeeCap:  types.NewInt(c.feeCap),
+			GasPremium: types.NewInt(c.premium),
+		}
+
+		capGasFee(msg, c.maxFee)
+
+		if !msg.GasFeeCap.Equals(types.NewInt(c.expCap)) || !msg.GasPremium.Equals(types.NewInt(c.expPrem)) {
+			t.Errorf("%s: expected fee cap %d and premium %d, got %s and %s", c.name, c.expCap, c.expPrem, msg.GasFeeCap, msg.GasPremium)
+		}
+	}
+}
+
+func TestGasEstimateMessageGasMaxFee(t *testing.T) {
+	estimates := []types.Message{
+		{GasLimit: 100, GasFeeCap: types.NewInt(10), GasPremium: types.NewInt(4)},
+		{GasLimit: 80, GasFeeCap: types.NewInt(12), GasPremium: types.NewInt(6)},
+	}
+
+	nodes := make([]*Node, 0, len(estimates))
+	for i := range estimates {
+		node, mock := newMockNode(fmt.Sprintf("node%d", i), RoleRead)
+		estimated := estimates[i]
+		mock.GasEstimateMessageGasFunc = func(context.Context, *types.Message, *api.MessageSendSpec, types.TipSetKey) (*types.Message, error) {
+			msg := estimated
+			return &msg, nil
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	opt := DefaultGasOption()
+	opt.Aggregate = true
+	opt.DefaultMaxFee = types.NewInt(900)
+
+	c, err := NewCoordinator(&Ctx{gasOpt: opt}, nil, types.NewInt(0), newMockSelector(t, nodes), nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	cases := []struct {
+		name   string
+		spec   *api.MessageSendSpec
+		feeCap uint64
+	}{
+		// max limit 100 and max fee cap 12, limited to 900 / 100
+		{"no spec", nil, 9},
+		{"zero max fee", &api.MessageSendSpec{MaxFee: types.NewInt(0)}, 9},
+		{"specified max fee", &api.MessageSendSpec{MaxFee: types.NewInt(500)}, 5},
+		{"enough max fee", &api.MessageSendSpec{MaxFee: types.NewInt(5000)}, 12},
+	}
+
+	for _, tc := range cases {
+		msg, err := c.GasEstimateMessageGas(context.Background(), &types.Message{}, tc.spec, types.EmptyTSK)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+
+		if msg.GasLimit != 100 || !msg.GasFeeCap.Equals(types.NewInt(tc.feeCap)) {
+			t.Errorf("%s: expected gas limit 100 and fee cap %d, got %d and %s", tc.name, tc.feeCap, msg.GasLimit, msg.GasFeeCap)
+		}
+	}
+}
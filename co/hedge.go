@@ -0,0 +1,217 @@
+package co
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// number of the recent latencies kept for each hedged method
+const hedgeSamples = 128
+
+// below this number of samples, the max delay is used
+const hedgeMinSamples = 16
+
+// DefaultHedgeOption returns default options, no method is hedged
+func DefaultHedgeOption() HedgeOption {
+	return HedgeOption{
+		Percentile: 0.95,
+		MinDelay:   50 * time.Millisecond,
+		MaxDelay:   2 * time.Second,
+	}
+}
+
+// HedgeOption is for the hedged requests
+type HedgeOption struct {
+	// Methods are the method names, or prefixes ending with "*", to be hedged
+	Methods []string
+
+	// Percentile of the recent latencies of a method, which is used as the delay
+	// before the backup request is sent
+	Percentile float64
+
+	// MinDelay and MaxDelay are the bounds of the delay
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+func newHedger(opt HedgeOption) *hedger {
+	return &hedger{
+		opt:       opt,
+		latencies: map[string]*latencyWindow{},
+	}
+}
+
+// hedger sends a backup request to another node if the first one is slower than
+// most of the recent requests of the same method
+type hedger struct {
+	opt HedgeOption
+
+	sync.Mutex
+	latencies map[string]*latencyWindow
+}
+
+func (h *hedger) hedged(method string) bool {
+	for _, pattern := range h.opt.Methods {
+		if (Route{Pattern: pattern}).Match(method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *hedger) window(method string) *latencyWindow {
+	h.Lock()
+	defer h.Unlock()
+
+	w, ok := h.latencies[method]
+	if !ok {
+		w = &latencyWindow{
+			samples: make([]time.Duration, 0, hedgeSamples),
+		}
+		h.latencies[method] = w
+	}
+
+	return w
+}
+
+func (h *hedger) delay(method string) time.Duration {
+	d, ok := h.window(method).percentile(h.opt.Percentile)
+	if !ok || d > h.opt.MaxDelay {
+		return h.opt.MaxDelay
+	}
+
+	if d < h.opt.MinDelay {
+		return h.opt.MinDelay
+	}
+
+	return d
+}
+
+// run makes the call on the first node, and on the second one as well if the first doesn't answer in time,
+// or fails. The results of the first successful call are committed, or the last failed one if both fail.
+func (h *hedger) run(ctx context.Context, method string, first, second *Node, call func(context.Context) (func(), error)) error {
+	window := h.window(method)
+
+	type result struct {
+		node   *Node
+		commit func()
+		err    error
+	}
+
+	results := make(chan result, 2)
+	cancels := make([]context.CancelFunc, 0, 2)
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+
+	launch := func(node *Node) {
+		cctx, cancel := context.WithCancel(withUpstream(ctx, node))
+		cancels = append(cancels, cancel)
+
+		go func() {
+			start := time.Now()
+			commit, err := call(cctx)
+			if err == nil {
+				window.add(time.Since(start))
+			}
+
+			results <- result{
+				node:   node,
+				commit: commit,
+				err:    err,
+			}
+		}()
+	}
+
+	launch(first)
+
+	timer := time.NewTimer(h.delay(method))
+	defer timer.Stop()
+
+	pending := 1
+	var last result
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if len(cancels) == 1 {
+				first.log.Debugf("%s is slow, send hedged request to %s", method, second.info.Host)
+				launch(second)
+				pending++
+			}
+
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				res.commit()
+				return nil
+			}
+
+			res.node.log.Warnf("hedged %s: %s", method, res.err)
+			last = res
+
+			// fail fast and try the backup node without waiting for the timer
+			if len(cancels) == 1 {
+				launch(second)
+				pending++
+			}
+		}
+	}
+
+	if last.commit != nil {
+		last.commit()
+	}
+
+	return last.err
+}
+
+// latencyWindow keeps the recent latencies of a method
+type latencyWindow struct {
+	sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) add(d time.Duration) {
+	w.Lock()
+	defer w.Unlock()
+
+	if len(w.samples) < hedgeSamples {
+		w.samples = append(w.samples, d)
+		return
+	}
+
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % hedgeSamples
+}
+
+func (w *latencyWindow) percentile(p float64) (time.Duration, bool) {
+	w.Lock()
+	if len(w.samples) < hedgeMinSamples {
+		w.Unlock()
+		return 0, false
+	}
+
+	sorted := make([]time.Duration, len(w.samples))
+	copy(sorted, w.samples)
+	w.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+
+	if idx < 0 {
+		idx = 0
+	}
+
+	return sorted[idx], true
+}
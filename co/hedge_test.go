@@ -0,0 +1,234 @@
+package co
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/fakenode"
+	"github.com/dtynn/chain-co/proxy"
+)
+
+func TestLatencyWindowPercentile(t *testing.T) {
+	w := &latencyWindow{}
+	for i := 1; i < hedgeMinSamples; i++ {
+		w.add(time.Duration(i) * time.Millisecond)
+	}
+
+	if d, ok := w.percentile(0.95); ok {
+		t.Fatalf("expected no percentile with %d samples, got %s", hedgeMinSamples-1, d)
+	}
+
+	// 1ms..100ms, added in the reverse order
+	w = &latencyWindow{}
+	for i := 100; i > 0; i-- {
+		w.add(time.Duration(i) * time.Millisecond)
+	}
+
+	cases := []struct {
+		p float64
+		d time.Duration
+	}{
+		{0, time.Millisecond},
+		{0.5, 51 * time.Millisecond},
+		{0.95, 96 * time.Millisecond},
+		{1, 100 * time.Millisecond},
+		{1.5, 100 * time.Millisecond},
+		{-1, time.Millisecond},
+	}
+
+	for _, c := range cases {
+		d, ok := w.percentile(c.p)
+		if !ok || d != c.d {
+			t.Errorf("p%v: expected %s, got %s, %v", c.p, c.d, d, ok)
+		}
+	}
+}
+
+func TestLatencyWindowRing(t *testing.T) {
+	w := &latencyWindow{}
+	for i := 0; i < hedgeSamples; i++ {
+		w.add(time.Second)
+	}
+
+	// the oldest half is replaced
+	for i := 0; i < hedgeSamples/2; i++ {
+		w.add(time.Millisecond)
+	}
+
+	if len(w.samples) != hedgeSamples {
+		t.Fatalf("expected %d samples kept, got %d", hedgeSamples, len(w.samples))
+	}
+
+	if d, _ := w.percentile(0.4); d != time.Millisecond {
+		t.Errorf("expected p40 to be the new samples, got %s", d)
+	}
+
+	if d, _ := w.percentile(0.6); d != time.Second {
+		t.Errorf("expected p60 to be the old samples, got %s", d)
+	}
+
+	for i := 0; i < hedgeSamples/2; i++ {
+		w.add(time.Millisecond)
+	}
+
+	if d, _ := w.percentile(1); d != time.Millisecond {
+		t.Errorf("expected all of the old samples to be replaced, got p100 %s", d)
+	}
+}
+
+func TestHedgerDelay(t *testing.T) {
+	opt := DefaultHedgeOption()
+
+	cases := []struct {
+		name    string
+		samples int
+		latency time.Duration
+		delay   time.Duration
+	}{
+		{"not enough samples", hedgeMinSamples - 1, 100 * time.Millisecond, opt.MaxDelay},
+		{"within bounds", hedgeMinSamples, 100 * time.Millisecond, 100 * time.Millisecond},
+		{"below min", hedgeMinSamples, time.Millisecond, opt.MinDelay},
+		{"above max", hedgeMinSamples, time.Minute, opt.MaxDelay},
+	}
+
+	for _, c := range cases {
+		h := newHedger(opt)
+		w := h.window("ChainHead")
+		for i := 0; i < c.samples; i++ {
+			w.add(c.latency)
+		}
+
+		if d := h.delay("ChainHead"); d != c.delay {
+			t.Errorf("%s: expected delay %s, got %s", c.name, c.delay, d)
+		}
+
+		// each method has its own window
+		if d := h.delay("ChainGetBlock"); d != opt.MaxDelay {
+			t.Errorf("%s: expected max delay for another method, got %s", c.name, d)
+		}
+	}
+}
+
+func TestHedgerHedged(t *testing.T) {
+	opt := DefaultHedgeOption()
+	opt.Methods = []string{"ChainHead", "StateGet*"}
+	h := newHedger(opt)
+
+	cases := []struct {
+		method string
+		hedged bool
+	}{
+		{"ChainHead", true},
+		{"ChainHeadX", false},
+		{"StateGetActor", true},
+		{"StateCall", false},
+	}
+
+	for _, c := range cases {
+		if hedged := h.hedged(c.method); hedged != c.hedged {
+			t.Errorf("%s: expected hedged %v, got %v", c.method, c.hedged, hedged)
+		}
+	}
+}
+
+// newRoutedProxy constructs a Proxy which goes through the router, in the same way as the service does
+func newRoutedProxy(router *Router, fallback Route) *proxy.Proxy {
+	return &proxy.Proxy{
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.ProxyAPI, error) {
+			return router.Select(ctx, method, args, fallback)
+		},
+		Wrap: func(ctx context.Context, method string, args []interface{}, call func(context.Context) (func(), error)) error {
+			return router.Call(ctx, method, args, fallback, call)
+		},
+	}
+}
+
+func TestRouterCallHedged(t *testing.T) {
+	chain, err := fakenode.NewChain(0)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	// whichever node is asked first hangs until the hedged request wins
+	var calls int32
+	cancelled := make(chan struct{})
+	chainHead := func(ctx context.Context) (*types.TipSet, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			<-ctx.Done()
+			close(cancelled)
+			return nil, ctx.Err()
+		}
+
+		return chain.Genesis(), nil
+	}
+
+	nodes := make([]*Node, 0, 2)
+	for _, host := range []string{"a", "b"} {
+		node, mock := newMockNode(host, RoleRead)
+		mock.ChainHeadFunc = chainHead
+		nodes = append(nodes, node)
+	}
+
+	hedgeOpt := HedgeOption{
+		Methods:    []string{"Chain*"},
+		Percentile: 0.95,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+
+	router, err := NewRouter(newMockSelector(t, nodes), nil, nil, DefaultArchiveOption(), hedgeOpt, DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	head, err := newRoutedProxy(router, Route{Policy: RouteRole, Role: RoleRead}).ChainHead(context.Background())
+	if err != nil {
+		t.Fatalf("call ChainHead: %s", err)
+	}
+
+	if head.Key() != chain.Genesis().Key() {
+		t.Errorf("expected the head from the hedged request, got %s", head.Key())
+	}
+
+	select {
+	case <-cancelled:
+
+	case <-time.After(5 * time.Second):
+		t.Error("expected the slow request to be cancelled")
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected ChainHead to be called on both of the nodes, got %d calls", n)
+	}
+}
+
+func TestRouterHedgeable(t *testing.T) {
+	router, err := NewRouter(nil, nil, nil, DefaultArchiveOption(), HedgeOption{Methods: []string{"*"}}, DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	cases := []struct {
+		route     Route
+		method    string
+		hedgeable bool
+	}{
+		{Route{Policy: RouteRole, Role: RoleRead}, "ChainHead", true},
+		{Route{Policy: RouteBest, Role: RoleRead}, "StateGetActor", true},
+		{Route{Policy: RouteRole, Role: RoleWrite}, "MpoolPush", false},
+		{Route{Policy: RouteRole, Role: RoleRead | RoleWrite}, "ChainHead", false},
+		{Route{Policy: RouteBroadcast, Role: RoleRead}, "ChainHead", false},
+		{Route{Policy: RouteReject, Role: RoleRead}, "ChainHead", false},
+		{Route{Policy: RouteRole, Role: RoleRead}, "ChainNotify", false},
+	}
+
+	for _, c := range cases {
+		if hedgeable := router.hedgeable(c.route, c.method); hedgeable != c.hedgeable {
+			t.Errorf("%s on %s/%s: expected hedgeable %v, got %v", c.method, c.route.Policy, c.route.Role, c.hedgeable, hedgeable)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package co
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// MinerGetBaseInfo impls api.FullNode.MinerGetBaseInfo, the request is sent to a node on the given tipset,
+// or any of the nodes if none is on it
+func (c *Coordinator) MinerGetBaseInfo(ctx context.Context, maddr address.Address, epoch abi.ChainEpoch, tsk types.TipSetKey) (*api.MiningBaseInfo, error) {
+	node, err := c.sel.SelectOnChain(ctx, RoleRead, tsk)
+	if err == ErrNoNodeOnTipSet {
+		node, err = c.sel.Select(RoleRead)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return node.FullNode().MinerGetBaseInfo(ctx, maddr, epoch, tsk)
+}
+
+// MinerCreateBlock impls api.FullNode.MinerCreateBlock, the request is sent to a node on the parent tipset.
+// A block created by a node on another chain would be invalid, so an error is returned if none is on it.
+func (c *Coordinator) MinerCreateBlock(ctx context.Context, bt *api.BlockTemplate) (*types.BlockMsg, error) {
+	node, err := c.sel.SelectOnChain(ctx, RoleRead, bt.Parents)
+	if err != nil {
+		return nil, fmt.Errorf("select node on parents %s: %w", bt.Parents, err)
+	}
+
+	return node.FullNode().MinerCreateBlock(ctx, bt)
+}
@@ -0,0 +1,125 @@
+package co
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/fakenode"
+	"github.com/dtynn/chain-co/proxy"
+)
+
+// serveChain fakes the chain methods of the mock with the given chain, the head is the latest tipset of the node
+func serveChain(t *testing.T, mock *proxy.MockFullNode, chain *fakenode.Chain, head *types.TipSet) {
+	mock.ChainGetTipSetFunc = func(_ context.Context, tsk types.TipSetKey) (*types.TipSet, error) {
+		return chain.TipSet(tsk)
+	}
+
+	mock.ChainGetTipSetByHeightFunc = func(_ context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error) {
+		if tsk != types.EmptyTSK {
+			t.Errorf("expected the tipset to be looked up from the head, got %s", tsk)
+		}
+
+		ts := head
+		for ts.Height() > height {
+			parent, err := chain.TipSet(ts.Parents())
+			if err != nil {
+				return nil, err
+			}
+
+			ts = parent
+		}
+
+		return ts, nil
+	}
+}
+
+func TestMinerCreateBlockOnChain(t *testing.T) {
+	chain, err := fakenode.NewChain(10)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	base, err := chain.Extend(chain.Genesis(), 0, 5, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	mainHead, err := chain.Extend(base, 0, 5, 2)
+	if err != nil {
+		t.Fatalf("extend main branch: %s", err)
+	}
+
+	forkHead, err := chain.Extend(base, 1, 3, 1)
+	if err != nil {
+		t.Fatalf("extend fork: %s", err)
+	}
+
+	// known by both of the nodes, but on neither of their chains
+	stale, err := chain.Extend(base, 2, 1, 1)
+	if err != nil {
+		t.Fatalf("extend stale branch: %s", err)
+	}
+
+	mainNode, mainMock := newMockNode("main", RoleAll)
+	forkNode, forkMock := newMockNode("fork", RoleAll)
+	serveChain(t, mainMock, chain, mainHead)
+	serveChain(t, forkMock, chain, forkHead)
+
+	// only the fork node has reported its head
+	forkNode.setHead(forkHead, types.NewInt(0))
+
+	for _, mock := range []*proxy.MockFullNode{mainMock, forkMock} {
+		mock.MinerCreateBlockFunc = func(context.Context, *api.BlockTemplate) (*types.BlockMsg, error) {
+			return &types.BlockMsg{}, nil
+		}
+	}
+
+	c, err := NewCoordinator(&Ctx{}, nil, types.NewInt(0), newMockSelector(t, []*Node{mainNode, forkNode}), nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	mainParent, err := chain.TipSet(mainHead.Parents())
+	if err != nil {
+		t.Fatalf("load parent of the main head: %s", err)
+	}
+
+	cases := []struct {
+		name    string
+		parents *types.TipSet
+		mock    *proxy.MockFullNode
+	}{
+		{"ancestor of the head", mainParent, mainMock},
+		{"reported head", forkHead, forkMock},
+	}
+
+	created := func(mock *proxy.MockFullNode) int {
+		return len(mock.MockLocal.CallsOf("MinerCreateBlock"))
+	}
+
+	for _, tc := range cases {
+		before := created(tc.mock)
+		if _, err := c.MinerCreateBlock(context.Background(), &api.BlockTemplate{Parents: tc.parents.Key()}); err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+
+		if created(tc.mock) != before+1 {
+			t.Errorf("%s: expected the block to be created on the node of the chain", tc.name)
+		}
+	}
+
+	before := created(mainMock) + created(forkMock)
+	if _, err := c.MinerCreateBlock(context.Background(), &api.BlockTemplate{Parents: stale.Key()}); !errors.Is(err, ErrNoNodeOnTipSet) {
+		t.Errorf("expected %s for the stale parents, got %v", ErrNoNodeOnTipSet, err)
+	}
+
+	if created(mainMock)+created(forkMock) != before {
+		t.Error("expected no block to be created on the stale parents")
+	}
+}
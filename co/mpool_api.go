@@ -0,0 +1,175 @@
+package co
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// MpoolGetNonce impls api.FullNode.MpoolGetNonce.
+// All the nodes on the best head will be queried and the max nonce is used,
+// the local tracked nonce is also taken into account if enabled.
+func (c *Coordinator) MpoolGetNonce(ctx context.Context, addr address.Address) (uint64, error) {
+	nodes := c.sel.candidates(RoleRead, true)
+	if len(nodes) == 0 {
+		node, err := c.sel.Select(RoleRead)
+		if err != nil {
+			return 0, err
+		}
+
+		nodes = []*Node{node}
+	}
+
+	nonces := make([]uint64, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "MpoolGetNonce", func(ctx context.Context, i int, full api.FullNode) error {
+		nonce, err := full.MpoolGetNonce(ctx, addr)
+		nonces[i] = nonce
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	next := uint64(0)
+	for i := range nonces {
+		if oks[i] && nonces[i] > next {
+			next = nonces[i]
+		}
+	}
+
+	if tracked, ok := c.ctx.nonces.next(addr); ok && tracked > next {
+		log.Debugw("use tracked nonce", "addr", addr, "tracked", tracked, "upstream", next)
+		next = tracked
+	}
+
+	return next, nil
+}
+
+// MpoolPending impls api.FullNode.MpoolPending.
+// Pending messages from all the usable upstream nodes, whatever their roles are,
+// will be merged and deduplicated by message cid.
+func (c *Coordinator) MpoolPending(ctx context.Context, tsk types.TipSetKey) ([]*types.SignedMessage, error) {
+	// every role has the empty role
+	nodes := c.sel.candidates(0, false)
+	if len(nodes) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+
+	pendings := make([][]*types.SignedMessage, len(nodes))
+	oks, err := queryNodes(ctx, nodes, "MpoolPending", func(ctx context.Context, i int, full api.FullNode) error {
+		msgs, err := full.MpoolPending(ctx, tsk)
+		pendings[i] = msgs
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[cid.Cid]struct{}{}
+	merged := make([]*types.SignedMessage, 0, len(pendings[0]))
+	for i := range pendings {
+		if !oks[i] {
+			continue
+		}
+
+		for _, msg := range pendings[i] {
+			mcid := msg.Cid()
+			if _, ok := seen[mcid]; ok {
+				continue
+			}
+
+			seen[mcid] = struct{}{}
+			merged = append(merged, msg)
+		}
+	}
+
+	return merged, nil
+}
+
+// MpoolPushMessage impls api.FullNode.MpoolPushMessage.
+// The gas is estimated and the nonce is assigned across the upstream nodes,
+// then the message is signed by the wallet backend and pushed to a write node.
+func (c *Coordinator) MpoolPushMessage(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec) (*types.SignedMessage, error) {
+	backend, err := c.wallet.use()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Nonce != 0 {
+		return nil, fmt.Errorf("MpoolPushMessage expects message nonce to be 0, was %d", msg.Nonce)
+	}
+
+	node, err := c.sel.Select(RoleRead)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := node.FullNode().StateAccountKey(ctx, msg.From, types.EmptyTSK)
+	if err != nil {
+		return nil, fmt.Errorf("resolve key address for %s: %w", msg.From, err)
+	}
+
+	// like lotus, always use the key address, so that the nonces of an account
+	// are tracked under one address whichever form the caller uses
+	cp := *msg
+	msg = &cp
+	msg.From = signer
+
+	estimated, err := c.GasEstimateMessageGas(ctx, msg, spec, types.EmptyTSK)
+	if err != nil {
+		return nil, fmt.Errorf("estimate message gas: %w", err)
+	}
+
+	c.wallet.pushLk.Lock()
+	defer c.wallet.pushLk.Unlock()
+
+	nonce, err := c.MpoolGetNonce(ctx, msg.From)
+	if err != nil {
+		return nil, fmt.Errorf("get nonce: %w", err)
+	}
+
+	if signed, ok := c.wallet.nonces.next(msg.From); ok && signed > nonce {
+		nonce = signed
+	}
+
+	estimated.Nonce = nonce
+
+	smsg, err := backend.WalletSignMessage(ctx, signer, estimated)
+	if err != nil {
+		return nil, fmt.Errorf("sign message: %w", err)
+	}
+
+	writer, err := c.sel.Select(RoleWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := writer.FullNode().MpoolPush(ctx, smsg); err != nil {
+		return nil, fmt.Errorf("push message to %s: %w", writer.info.Host, err)
+	}
+
+	c.wallet.nonces.record(smsg)
+	c.ctx.nonces.record(smsg)
+
+	return smsg, nil
+}
+
+// MpoolBatchPushMessage impls api.FullNode.MpoolBatchPushMessage, the messages are pushed one by one
+func (c *Coordinator) MpoolBatchPushMessage(ctx context.Context, msgs []*types.Message, spec *api.MessageSendSpec) ([]*types.SignedMessage, error) {
+	smsgs := make([]*types.SignedMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		smsg, err := c.MpoolPushMessage(ctx, msg, spec)
+		if err != nil {
+			return smsgs, err
+		}
+
+		smsgs = append(smsgs, smsg)
+	}
+
+	return smsgs, nil
+}
@@ -0,0 +1,202 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+
+	"github.com/dtynn/chain-co/proxy"
+)
+
+func TestMpoolGetNonce(t *testing.T) {
+	nonces := map[string]uint64{"a": 3, "b": 5, "behind": 9}
+
+	nodes := make([]*Node, 0, len(nonces))
+	mocks := map[string]*proxy.MockFullNode{}
+	for host, nonce := range nonces {
+		node, mock := newMockNode(host, RoleAll)
+		mocks[host] = mock
+		nonce := nonce
+		mock.MpoolGetNonceFunc = func(context.Context, address.Address) (uint64, error) {
+			return nonce, nil
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	ctx := &Ctx{nonces: newNonceTracker(NonceOption{Track: true, TTL: time.Minute})}
+	c, err := NewCoordinator(ctx, nil, types.NewInt(0), newMockSelector(t, nodes, "a", "b"), nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	from := signedMsg(t, 1000, 0).Message.From
+
+	// the node behind the best head is not asked
+	nonce, err := c.MpoolGetNonce(context.Background(), from)
+	if err != nil {
+		t.Fatalf("get nonce: %s", err)
+	}
+
+	if nonce != 5 {
+		t.Errorf("expected the max nonce of the nodes on the best head, got %d", nonce)
+	}
+
+	if calls := mocks["behind"].MockLocal.Calls(); len(calls) != 0 {
+		t.Errorf("expected no call on the node behind, got %v", calls)
+	}
+
+	ctx.nonces.record(signedMsg(t, 1000, 7))
+	if nonce, _ := c.MpoolGetNonce(context.Background(), from); nonce != 8 {
+		t.Errorf("expected the tracked nonce, got %d", nonce)
+	}
+}
+
+func TestMpoolPending(t *testing.T) {
+	msgs := make([]*types.SignedMessage, 4)
+	for i := range msgs {
+		msgs[i] = signedMsg(t, 1000, uint64(i))
+		msgs[i].Message.To = msgs[i].Message.From
+	}
+
+	// only seen by the refused node
+	foreign := msgs[3]
+	msgs = msgs[:3]
+
+	pendings := []struct {
+		host  string
+		roles NodeRole
+		msgs  []*types.SignedMessage
+	}{
+		{"reader", RoleRead, msgs[:2]},
+		{"writer", RoleWrite, msgs[1:]},
+		{"refused", RoleAll, []*types.SignedMessage{foreign}},
+	}
+
+	nodes := make([]*Node, 0, len(pendings))
+	for _, p := range pendings {
+		node, mock := newMockNode(p.host, p.roles)
+		pending := p.msgs
+		mock.MpoolPendingFunc = func(context.Context, types.TipSetKey) ([]*types.SignedMessage, error) {
+			return pending, nil
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	nodes[2].status.netErr = ErrNetworkMismatch
+
+	c, err := NewCoordinator(&Ctx{}, nil, types.NewInt(0), newMockSelector(t, nodes), nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	merged, err := c.MpoolPending(context.Background(), types.EmptyTSK)
+	if err != nil {
+		t.Fatalf("get pending messages: %s", err)
+	}
+
+	if len(merged) != len(msgs) {
+		t.Fatalf("expected %d messages from the read and write nodes, got %d", len(msgs), len(merged))
+	}
+
+	seen := map[uint64]bool{}
+	for _, msg := range merged {
+		if msg.Message.Nonce == foreign.Message.Nonce || seen[msg.Message.Nonce] {
+			t.Errorf("unexpected message with nonce %d", msg.Message.Nonce)
+		}
+
+		seen[msg.Message.Nonce] = true
+	}
+}
+
+func TestMpoolPushMessage(t *testing.T) {
+	reader, rmock := newMockNode("reader", RoleRead)
+	writer, wmock := newMockNode("writer", RoleWrite)
+
+	from := signedMsg(t, 1000, 0).Message.From
+	key := signedMsg(t, 1001, 0).Message.From
+
+	rmock.StateAccountKeyFunc = func(context.Context, address.Address, types.TipSetKey) (address.Address, error) {
+		return key, nil
+	}
+
+	rmock.GasEstimateMessageGasFunc = func(_ context.Context, msg *types.Message, _ *api.MessageSendSpec, _ types.TipSetKey) (*types.Message, error) {
+		estimated := *msg
+		estimated.GasLimit = 100
+		return &estimated, nil
+	}
+
+	for _, mock := range []*proxy.MockFullNode{rmock, wmock} {
+		mock.MpoolGetNonceFunc = func(context.Context, address.Address) (uint64, error) {
+			return 3, nil
+		}
+	}
+
+	pushed := 0
+	wmock.MpoolPushFunc = func(context.Context, *types.SignedMessage) (cid.Cid, error) {
+		pushed++
+		if pushed > 3 {
+			return cid.Undef, fmt.Errorf("mpool is full")
+		}
+
+		return cid.Undef, nil
+	}
+
+	backend := proxy.NewMockFullNode()
+	backend.WalletSignMessageFunc = func(_ context.Context, _ address.Address, msg *types.Message) (*types.SignedMessage, error) {
+		return &types.SignedMessage{Message: *msg}, nil
+	}
+
+	ctx := &Ctx{
+		gasOpt: DefaultGasOption(),
+		nonces: newNonceTracker(NonceOption{Track: true, TTL: time.Minute}),
+	}
+
+	wallet := &Wallet{backend: backend, nonces: newNonceTracker(NonceOption{Track: true, TTL: time.Minute})}
+	c, err := NewCoordinator(ctx, nil, types.NewInt(0), newMockSelector(t, []*Node{reader, writer}, "reader", "writer"), wallet, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	// the upstream nodes keep reporting nonce 3, the signed nonces come from the wallet
+	for i, expected := range []uint64{3, 4} {
+		smsg, err := c.MpoolPushMessage(context.Background(), &types.Message{From: from, To: from}, nil)
+		if err != nil {
+			t.Fatalf("push message #%d: %s", i, err)
+		}
+
+		if smsg.Message.From != key || smsg.Message.Nonce != expected || smsg.Message.GasLimit != 100 {
+			t.Errorf("push message #%d: expected estimated message from %s with nonce %d, got %s, %d, %d", i, key, expected, smsg.Message.From, smsg.Message.Nonce, smsg.Message.GasLimit)
+		}
+	}
+
+	if calls := rmock.MockLocal.CallsOf("MpoolPush"); len(calls) != 0 {
+		t.Errorf("expected no push on the read node, got %d", len(calls))
+	}
+
+	if _, err := c.MpoolPushMessage(context.Background(), &types.Message{From: from, To: from, Nonce: 1}, nil); err == nil {
+		t.Error("expected a message with nonce to be rejected")
+	}
+
+	// the batch stops at the first failed push
+	msgs := []*types.Message{{From: from, To: from}, {From: from, To: from}, {From: from, To: from}}
+	smsgs, err := c.MpoolBatchPushMessage(context.Background(), msgs, nil)
+	if err == nil {
+		t.Fatal("expected the batch push to fail")
+	}
+
+	if len(smsgs) != 1 || smsgs[0].Message.Nonce != 5 {
+		t.Errorf("expected the message with nonce 5 before the failure, got %d messages", len(smsgs))
+	}
+
+	if next, _ := wallet.nonces.next(key); next != 6 {
+		t.Errorf("expected the failed push not to be tracked, got next nonce %d", next)
+	}
+}
@@ -0,0 +1,156 @@
+package co
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/ipfs/go-cid"
+	"github.com/whyrusleeping/pubsub"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+const (
+	mpoolUpdateTopic = "mpoolupdate"
+)
+
+type mpoolUpdateKey struct {
+	typ api.MpoolChange
+	c   cid.Cid
+}
+
+func newMpoolHub(size int) (*mpoolHub, error) {
+	seen, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mpoolHub{
+		pub:  pubsub.New(256),
+		seen: seen,
+	}, nil
+}
+
+// mpoolHub merges the mpool updates from all the upstream nodes,
+// the same update reported by different nodes will only be published once
+type mpoolHub struct {
+	pub  *pubsub.PubSub
+	seen *lru.Cache
+}
+
+func (mh *mpoolHub) publish(update api.MpoolUpdate) {
+	key := mpoolUpdateKey{
+		typ: update.Type,
+		c:   update.Message.Cid(),
+	}
+
+	if ok, _ := mh.seen.ContainsOrAdd(key, struct{}{}); ok {
+		return
+	}
+
+	mh.pub.Pub(update, mpoolUpdateTopic)
+}
+
+// mpoolLoop subscribes the mpool updates from the upstream node, and re-subscribes if the channel is closed
+func (n *Node) mpoolLoop() {
+	n.log.Info("start mpool update loop")
+	defer n.log.Info("stop mpool update loop")
+
+	interval := n.opt.ReListenMinInterval
+	for {
+		ch, err := n.upstream.full.MpoolSub(n.ctx)
+		if err != nil {
+			n.log.Errorf("call MpoolSub: %s, will re-call in %s", err, interval)
+			select {
+			case <-n.ctx.Done():
+				return
+
+			case <-time.After(interval):
+				interval *= 2
+				if interval > n.opt.ReListenMaxInterval {
+					interval = n.opt.ReListenMaxInterval
+				}
+			}
+
+			continue
+		}
+
+		interval = n.opt.ReListenMinInterval
+
+	UPDATES_LOOP:
+		for {
+			select {
+			case <-n.ctx.Done():
+				return
+
+			case update, ok := <-ch:
+				if !ok {
+					break UPDATES_LOOP
+				}
+
+				// the messages of a node on another network should not be mixed in
+				if !n.usable() {
+					continue
+				}
+
+				n.sctx.mpool.publish(update)
+			}
+		}
+	}
+}
+
+// MpoolSub impls api.FullNode.MpoolSub, the updates from all the usable upstream nodes are merged,
+// whatever their roles are
+func (c *Coordinator) MpoolSub(ctx context.Context) (<-chan api.MpoolUpdate, error) {
+	subch := c.ctx.mpool.pub.Sub(mpoolUpdateTopic)
+
+	out := make(chan api.MpoolUpdate, 32)
+
+	done := make(chan struct{}, 0)
+	go func() {
+		select {
+		case <-ctx.Done():
+
+		case <-c.ctx.lc.Done():
+
+		}
+
+		close(done)
+	}()
+
+	go func() {
+		defer func() {
+			close(out)
+			c.ctx.mpool.pub.Unsub(subch)
+			for range subch {
+			}
+		}()
+
+		for {
+			select {
+			case val, ok := <-subch:
+				if !ok {
+					log.Info("MpoolSub: request done")
+					return
+				}
+
+				select {
+				case out <- val.(api.MpoolUpdate):
+
+				case <-done:
+					return
+
+				case <-time.After(time.Minute):
+					log.Warn("MpoolSub: stucked for 1min")
+					return
+				}
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
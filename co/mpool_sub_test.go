@@ -0,0 +1,69 @@
+package co
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+func TestMpoolSubFromReadNode(t *testing.T) {
+	hub, err := newMpoolHub(16)
+	if err != nil {
+		t.Fatalf("construct mpool hub: %s", err)
+	}
+
+	sub := hub.pub.Sub(mpoolUpdateTopic)
+	defer hub.pub.Unsub(sub)
+
+	ctx := &Ctx{mpool: hub}
+
+	subscribed := func(msg *types.SignedMessage) func(context.Context) (<-chan api.MpoolUpdate, error) {
+		return func(context.Context) (<-chan api.MpoolUpdate, error) {
+			ch := make(chan api.MpoolUpdate, 1)
+			ch <- api.MpoolUpdate{Type: api.MpoolAdd, Message: msg}
+			return ch, nil
+		}
+	}
+
+	dropped := signedMsg(t, 1000, 1)
+	dropped.Message.To = dropped.Message.From
+
+	refused, rmock := newMockNode("refused", RoleRead)
+	refused.sctx = ctx
+	refused.status.netErr = ErrNetworkMismatch
+	rmock.MpoolSubFunc = subscribed(dropped)
+
+	msg := signedMsg(t, 1000, 2)
+	msg.Message.To = msg.Message.From
+
+	// not a notify node, but the mpool updates are still subscribed
+	reader, mock := newMockNode("reader", RoleRead)
+	reader.sctx = ctx
+	mock.MpoolSubFunc = subscribed(msg)
+
+	defer refused.Stop()
+	defer reader.Stop()
+
+	refused.Start()
+	reader.Start()
+
+	select {
+	case val := <-sub:
+		if update := val.(api.MpoolUpdate); update.Message.Cid() != msg.Cid() {
+			t.Fatalf("expected the update from the read node, got %s", update.Message.Cid())
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the update from the read node to be published")
+	}
+
+	select {
+	case val := <-sub:
+		t.Fatalf("expected the update from the refused node to be dropped, got %s", val.(api.MpoolUpdate).Message.Cid())
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,76 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+)
+
+// DefaultNetworkOption returns default options, the expected values will be taken from the first connected node
+func DefaultNetworkOption() NetworkOption {
+	return NetworkOption{
+		Genesis: cid.Undef,
+	}
+}
+
+// NetworkOption describes the network that all upstream nodes are expected to be on.
+// Empty fields will be taken from the first connected node.
+type NetworkOption struct {
+	Genesis     cid.Cid
+	NetworkName dtypes.NetworkName
+}
+
+func newNetworkGuard(opt NetworkOption) *networkGuard {
+	return &networkGuard{
+		genesis: opt.Genesis,
+		name:    opt.NetworkName,
+	}
+}
+
+// networkGuard makes sure that all upstream nodes share the same genesis and network name
+type networkGuard struct {
+	sync.Mutex
+	genesis cid.Cid
+	name    dtypes.NetworkName
+}
+
+func (g *networkGuard) check(ctx context.Context, full api.FullNode) error {
+	gts, err := full.ChainGetGenesis(ctx)
+	if err != nil {
+		return fmt.Errorf("call ChainGetGenesis: %w", err)
+	}
+
+	gcids := gts.Cids()
+	if len(gcids) != 1 {
+		return fmt.Errorf("%w: expected 1 genesis block, got %d", ErrNetworkMismatch, len(gcids))
+	}
+
+	name, err := full.StateNetworkName(ctx)
+	if err != nil {
+		return fmt.Errorf("call StateNetworkName: %w", err)
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	if g.genesis.Defined() && g.genesis != gcids[0] {
+		return fmt.Errorf("%w: expected genesis %s, got %s", ErrNetworkMismatch, g.genesis, gcids[0])
+	}
+
+	if g.name != "" && g.name != name {
+		return fmt.Errorf("%w: expected network %q, got %q", ErrNetworkMismatch, g.name, name)
+	}
+
+	if !g.genesis.Defined() || g.name == "" {
+		log.Infow("expected network is set", "genesis", gcids[0], "name", name)
+	}
+
+	g.genesis = gcids[0]
+	g.name = name
+	return nil
+}
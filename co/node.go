@@ -2,6 +2,9 @@ package co
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/filecoin-project/go-jsonrpc"
@@ -13,7 +16,6 @@ import (
 	"github.com/filecoin-project/lotus/api/client"
 	"github.com/filecoin-project/lotus/chain/store"
 	"github.com/filecoin-project/lotus/chain/types"
-	"github.com/filecoin-project/lotus/cli/util"
 )
 
 // NodeInfoList is a type def for dependency injection
@@ -36,22 +38,24 @@ type NodeOption struct {
 	APITimeout time.Duration
 }
 
-// NodeInfo is a type alias for cliutil.APIInfo
-type NodeInfo = cliutil.APIInfo
-
-// ParseNodeInfo is an alias to the cliutil.ParseApiInfo function
-var ParseNodeInfo = cliutil.ParseApiInfo
-
 // NewConnector constructs a Connector instance
 func NewConnector(ctx *Ctx) (*Connector, error) {
-	return &Connector{
+	c := &Connector{
 		Ctx: ctx,
-	}, nil
+	}
+
+	c.dialing.errs = map[string]string{}
+	return c, nil
 }
 
 // Connector is a helper for connecting upstream nodes
 type Connector struct {
 	*Ctx
+
+	dialing struct {
+		sync.Mutex
+		errs map[string]string
+	}
 }
 
 // Connect connects to the specified node with given info
@@ -66,22 +70,94 @@ func (c *Connector) Connect(info NodeInfo) (*Node, error) {
 		return nil, err
 	}
 
+	opt := info.Opt.merge(c.Ctx.nodeOpt)
+
+	checkCtx, checkCancel := context.WithTimeout(c.Ctx.lc, opt.APITimeout)
+	err = c.Ctx.network.check(checkCtx, full)
+	checkCancel()
+
+	if err != nil {
+		closer()
+		return nil, fmt.Errorf("validate network for %s: %w", addr, err)
+	}
+
 	ctx, cancel := context.WithCancel(c.Ctx.lc)
 	node := &Node{
-		opt:    c.Ctx.nodeOpt,
-		info:   info,
+		opt:  opt,
+		info: info,
+
+		reListenInterval: opt.ReListenMinInterval,
+
 		ctx:    ctx,
 		cancel: cancel,
 		sctx:   c.Ctx,
-		log:    log.With("remote", addr),
+		log:    log.With("remote", addr, "roles", info.Roles.String()),
 	}
 
+	node.pending.notify = make(chan struct{}, 1)
+
 	node.upstream.full = full
 	node.upstream.closer = closer
 
 	return node, nil
 }
 
+// Dial keeps trying to connect to the specified node in background, using the re-listen backoff
+// between attempts. The connected node will be passed to the given callback.
+func (c *Connector) Dial(info NodeInfo, connected func(*Node)) {
+	go func() {
+		opt := info.Opt.merge(c.Ctx.nodeOpt)
+		interval := opt.ReListenMinInterval
+		for {
+			node, err := c.Connect(info)
+
+			c.dialing.Lock()
+			if err == nil {
+				delete(c.dialing.errs, info.Host)
+			} else {
+				c.dialing.errs[info.Host] = err.Error()
+			}
+			c.dialing.Unlock()
+
+			if err == nil {
+				log.Infow("node connected", "host", info.Host)
+				connected(node)
+				return
+			}
+
+			log.Warnf("connect to %s failed: %s, will retry in %s", info.Host, err, interval)
+
+			select {
+			case <-c.Ctx.lc.Done():
+				return
+
+			case <-time.After(interval):
+				interval *= 2
+				if interval > opt.ReListenMaxInterval {
+					interval = opt.ReListenMaxInterval
+				}
+			}
+		}
+	}()
+}
+
+// Dialing returns the status of the nodes that are still being connected in background
+func (c *Connector) Dialing() []NodeStatus {
+	c.dialing.Lock()
+	defer c.dialing.Unlock()
+
+	sts := make([]NodeStatus, 0, len(c.dialing.errs))
+	for host, err := range c.dialing.errs {
+		sts = append(sts, NodeStatus{
+			Host:  host,
+			State: NodeStateConnecting,
+			Error: err,
+		})
+	}
+
+	return sts
+}
+
 // Node is a FullNode client
 type Node struct {
 	opt  NodeOption
@@ -94,6 +170,24 @@ type Node struct {
 
 	sctx *Ctx
 
+	// pending holds the latest head that has not been sent to the coordinator yet,
+	// older ones will be overwritten so that the apply loop always works on the newest head
+	pending struct {
+		sync.Mutex
+		ts     *types.TipSet
+		notify chan struct{}
+	}
+
+	status struct {
+		sync.RWMutex
+		netErr    error
+		listening bool
+		head      *types.TipSet
+		weight    types.BigInt
+		headAt    time.Time
+		lastErr   string
+	}
+
 	upstream struct {
 		full   api.FullNode
 		closer jsonrpc.ClientCloser
@@ -102,13 +196,28 @@ type Node struct {
 	log *zap.SugaredLogger
 }
 
-// Start starts a head change loop
+// Start starts the mpool update loop, and the head change loop for the notify nodes,
+// nodes without the notify role don't take part in choosing the head
 func (n *Node) Start() {
+	go n.mpoolLoop()
+
+	if !n.info.Roles.Has(RoleNotify) {
+		n.log.Info("not a notify node, skip head change loop")
+		return
+	}
+
 	n.log.Info("start head change loop")
 	defer n.log.Info("stop head change loop")
 
+	go n.applyLoop()
+
+	// the network has been validated in Connector.Connect, but the upstream may be
+	// replaced while we are reconnecting
+	validate := false
+
 	for {
-		ch, err := n.reListen()
+		ch, err := n.reListen(validate)
+		validate = true
 		if err != nil {
 			if err != context.Canceled && err != context.DeadlineExceeded {
 				n.log.Errorf("failed to listen head change: %s", err)
@@ -116,13 +225,12 @@ func (n *Node) Start() {
 			return
 		}
 
-		chLifeCtx, chLifeCancel := context.WithCancel(n.ctx)
+		n.setListening(true)
 
 	CHANGES_LOOP:
 		for {
 			select {
 			case <-n.ctx.Done():
-				chLifeCancel()
 				return
 
 			case changes, ok := <-ch:
@@ -130,11 +238,11 @@ func (n *Node) Start() {
 					break CHANGES_LOOP
 				}
 
-				go n.applyChanges(chLifeCtx, changes)
+				n.queueChanges(changes)
 			}
 		}
 
-		chLifeCancel()
+		n.setListening(false)
 	}
 }
 
@@ -150,23 +258,60 @@ func (n *Node) FullNode() api.FullNode {
 	return n.upstream.full
 }
 
-func (n *Node) reListen() (<-chan []*api.HeadChange, error) {
-	for {
-		ch, err := n.upstream.full.ChainNotify(n.ctx)
-		if err != nil {
-			n.log.Errorf("call CahinNotify: %s, will re-call in %s", err, n.reListenInterval)
+// Info returns the info of the node
+func (n *Node) Info() NodeInfo {
+	return n.info
+}
 
-			select {
-			case <-n.ctx.Done():
-				return nil, n.ctx.Err()
+// Option returns the options of the node, merged with the global ones
+func (n *Node) Option() NodeOption {
+	return n.opt
+}
+
+// usable returns false if the node has been refused and should not serve any request
+func (n *Node) usable() bool {
+	n.status.RLock()
+	defer n.status.RUnlock()
+	return n.status.netErr == nil
+}
+
+func (n *Node) validateNetwork() error {
+	ctx, cancel := context.WithTimeout(n.ctx, n.opt.APITimeout)
+	err := n.sctx.network.check(ctx, n.upstream.full)
+	cancel()
+
+	// only a confirmed mismatch refuses the node, other errors will be found by the following calls
+	if err != nil && !errors.Is(err, ErrNetworkMismatch) {
+		return err
+	}
+
+	n.status.Lock()
+	n.status.netErr = err
+	n.status.Unlock()
 
-			case <-time.After(n.reListenInterval):
+	return err
+}
 
-				n.reListenInterval *= 2
-				if n.reListenInterval > n.opt.ReListenMaxInterval {
-					n.reListenInterval = n.opt.ReListenMaxInterval
+func (n *Node) reListen(validate bool) (<-chan []*api.HeadChange, error) {
+	for {
+		if validate {
+			if err := n.validateNetwork(); err != nil {
+				n.setError(err)
+				n.log.Errorf("validate network: %s, will re-check in %s", err, n.reListenInterval)
+				if err := n.waitReListen(); err != nil {
+					return nil, err
 				}
 
+				continue
+			}
+		}
+
+		ch, err := n.upstream.full.ChainNotify(n.ctx)
+		if err != nil {
+			n.setError(err)
+			n.log.Errorf("call CahinNotify: %s, will re-call in %s", err, n.reListenInterval)
+			if err := n.waitReListen(); err != nil {
+				return nil, err
 			}
 
 			continue
@@ -177,7 +322,24 @@ func (n *Node) reListen() (<-chan []*api.HeadChange, error) {
 	}
 }
 
-func (n *Node) applyChanges(lifeCtx context.Context, changes []*api.HeadChange) {
+func (n *Node) waitReListen() error {
+	select {
+	case <-n.ctx.Done():
+		return n.ctx.Err()
+
+	case <-time.After(n.reListenInterval):
+
+		n.reListenInterval *= 2
+		if n.reListenInterval > n.opt.ReListenMaxInterval {
+			n.reListenInterval = n.opt.ReListenMaxInterval
+		}
+
+	}
+
+	return nil
+}
+
+func (n *Node) queueChanges(changes []*api.HeadChange) {
 	n.sctx.bcache.add(changes)
 
 	idx := -1
@@ -194,15 +356,56 @@ func (n *Node) applyChanges(lifeCtx context.Context, changes []*api.HeadChange)
 
 	ts := changes[idx].Val
 
-	callCtx, callCancel := context.WithTimeout(lifeCtx, n.opt.APITimeout)
+	n.pending.Lock()
+	if prev := n.pending.ts; prev != nil {
+		n.log.Debugf("pending head at height %d is replaced by %d before applied", prev.Height(), ts.Height())
+	}
+	n.pending.ts = ts
+	n.pending.Unlock()
+
+	select {
+	case n.pending.notify <- struct{}{}:
+
+	default:
+
+	}
+}
+
+// applyLoop sends the pending heads to the coordinator one by one,
+// so that the candidates from the same node are always delivered in order
+func (n *Node) applyLoop() {
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+
+		case <-n.pending.notify:
+		}
+
+		n.pending.Lock()
+		ts := n.pending.ts
+		n.pending.ts = nil
+		n.pending.Unlock()
+
+		if ts != nil {
+			n.applyHead(ts)
+		}
+	}
+}
+
+func (n *Node) applyHead(ts *types.TipSet) {
+	callCtx, callCancel := context.WithTimeout(n.ctx, n.opt.APITimeout)
 	weight, err := n.upstream.full.ChainTipSetWeight(callCtx, ts.Key())
 	callCancel()
 
 	if err != nil {
+		n.setError(err)
 		n.log.Errorf("call ChainTipSetWeight: %s", err)
 		return
 	}
 
+	n.setHead(ts, weight)
+
 	hc := &headCandidate{
 		node:   n,
 		ts:     ts,
@@ -216,7 +419,7 @@ func (n *Node) applyChanges(lifeCtx context.Context, changes []*api.HeadChange)
 
 	for {
 		select {
-		case <-lifeCtx.Done():
+		case <-n.ctx.Done():
 			return
 
 		case n.sctx.headCh <- hc:
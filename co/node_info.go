@@ -0,0 +1,194 @@
+package co
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/lotus/cli/util"
+)
+
+// NodeRole is a set of the usages of a node
+type NodeRole uint8
+
+// node roles
+const (
+	// RoleNotify nodes feed their heads into the Coordinator
+	RoleNotify NodeRole = 1 << iota
+	// RoleRead nodes serve the proxied read requests
+	RoleRead
+	// RoleWrite nodes serve the proxied write requests, such as message pushing
+	RoleWrite
+	// RoleArchive nodes keep the full history, and serve the requests for the epochs beyond the archive horizon
+	RoleArchive
+
+	// RoleAll doesn't include RoleArchive, which should be tagged explicitly
+	RoleAll = RoleNotify | RoleRead | RoleWrite
+)
+
+var roleNames = []struct {
+	role NodeRole
+	name string
+}{
+	{RoleNotify, "notify"},
+	{RoleRead, "read"},
+	{RoleWrite, "write"},
+	{RoleArchive, "archive"},
+}
+
+// ParseNodeRole parses a comma separated role list, e.g. "notify,read"
+func ParseNodeRole(s string) (NodeRole, error) {
+	var role NodeRole
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if part == "all" {
+			role |= RoleAll
+			continue
+		}
+
+		found := false
+		for _, rn := range roleNames {
+			if rn.name == part {
+				role |= rn.role
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return 0, fmt.Errorf("unknown node role %q", part)
+		}
+	}
+
+	if role == 0 {
+		return 0, fmt.Errorf("empty node role")
+	}
+
+	return role, nil
+}
+
+// Has returns true if all of the given roles are included
+func (r NodeRole) Has(o NodeRole) bool {
+	return r&o == o
+}
+
+func (r NodeRole) String() string {
+	names := make([]string, 0, len(roleNames))
+	for _, rn := range roleNames {
+		if r.Has(rn.role) {
+			names = append(names, rn.name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+// NodeInfo describes an upstream node
+type NodeInfo struct {
+	cliutil.APIInfo
+
+	// Host is the dial address without the scheme and path, used as a readable name of the node
+	Host string
+
+	// Weight affects the chance of being selected among the candidates
+	Weight int
+	Roles  NodeRole
+	Labels map[string]string
+
+	// Opt overrides the global NodeOption, zero fields are ignored
+	Opt NodeOption
+}
+
+// NewNodeInfo constructs a NodeInfo with default attributes from the api info
+func NewNodeInfo(api cliutil.APIInfo) (NodeInfo, error) {
+	host, err := api.Host()
+	if err != nil {
+		return NodeInfo{}, fmt.Errorf("invalid api info %s: %w", api.Addr, err)
+	}
+
+	return NodeInfo{
+		APIInfo: api,
+		Host:    host,
+		Weight:  1,
+		Roles:   RoleAll,
+		Labels:  map[string]string{},
+	}, nil
+}
+
+// ParseNodeInfo parses the node spec in the form of
+// token:multiaddr[;weight=1][;roles=notify,read,write,archive][;api-timeout=10s][;relisten-min=4s][;relisten-max=32s][;label.name=value]
+func ParseNodeInfo(s string) (NodeInfo, error) {
+	parts := strings.Split(s, ";")
+	info, err := NewNodeInfo(cliutil.ParseApiInfo(parts[0]))
+	if err != nil {
+		return info, err
+	}
+
+	for _, attr := range parts[1:] {
+		kv := strings.SplitN(attr, "=", 2)
+		if len(kv) != 2 {
+			return info, fmt.Errorf("invalid node attribute %q", attr)
+		}
+
+		if err := info.setAttr(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])); err != nil {
+			return info, fmt.Errorf("invalid node attribute %q: %w", attr, err)
+		}
+	}
+
+	return info, nil
+}
+
+func (info *NodeInfo) setAttr(key, val string) error {
+	if strings.HasPrefix(key, "label.") {
+		info.Labels[strings.TrimPrefix(key, "label.")] = val
+		return nil
+	}
+
+	var err error
+	switch key {
+	case "weight":
+		info.Weight, err = strconv.Atoi(val)
+		if err == nil && info.Weight <= 0 {
+			err = fmt.Errorf("weight should be positive")
+		}
+
+	case "roles", "role":
+		info.Roles, err = ParseNodeRole(val)
+
+	case "api-timeout":
+		info.Opt.APITimeout, err = time.ParseDuration(val)
+
+	case "relisten-min":
+		info.Opt.ReListenMinInterval, err = time.ParseDuration(val)
+
+	case "relisten-max":
+		info.Opt.ReListenMaxInterval, err = time.ParseDuration(val)
+
+	default:
+		err = fmt.Errorf("unknown key")
+	}
+
+	return err
+}
+
+// merge returns a copy of the given options, overridden by the non-zero fields in o
+func (o NodeOption) merge(base NodeOption) NodeOption {
+	if o.ReListenMinInterval > 0 {
+		base.ReListenMinInterval = o.ReListenMinInterval
+	}
+
+	if o.ReListenMaxInterval > 0 {
+		base.ReListenMaxInterval = o.ReListenMaxInterval
+	}
+
+	if o.APITimeout > 0 {
+		base.APITimeout = o.APITimeout
+	}
+
+	return base
+}
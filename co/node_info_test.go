@@ -0,0 +1,164 @@
+package co
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNodeRole(t *testing.T) {
+	cases := []struct {
+		s    string
+		role NodeRole
+		err  bool
+	}{
+		{"notify", RoleNotify, false},
+		{"read,write", RoleRead | RoleWrite, false},
+		{" read , write ", RoleRead | RoleWrite, false},
+		{"all", RoleAll, false},
+		{"all,read", RoleAll, false},
+		{"all,archive", RoleAll | RoleArchive, false},
+		{"read,,notify", RoleNotify | RoleRead, false},
+		{"", 0, true},
+		{",", 0, true},
+		{"read,sign", 0, true},
+		{"READ", 0, true},
+	}
+
+	for _, c := range cases {
+		role, err := ParseNodeRole(c.s)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %s", c.s, role)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.s, err)
+			continue
+		}
+
+		if role != c.role {
+			t.Errorf("%q: expected %s, got %s", c.s, c.role, role)
+		}
+	}
+}
+
+func TestNodeRoleString(t *testing.T) {
+	if s := RoleAll.String(); s != "notify,read,write" {
+		t.Errorf("unexpected string of RoleAll: %q", s)
+	}
+
+	if s := (RoleWrite | RoleNotify).String(); s != "notify,write" {
+		t.Errorf("unexpected string of notify and write: %q", s)
+	}
+
+	if s := (RoleArchive | RoleRead).String(); s != "read,archive" {
+		t.Errorf("unexpected string of read and archive: %q", s)
+	}
+}
+
+func TestParseNodeInfo(t *testing.T) {
+	const addr = "header.payload.sig:/ip4/127.0.0.1/tcp/1234/http"
+
+	cases := []struct {
+		s      string
+		weight int
+		roles  NodeRole
+		labels map[string]string
+		opt    NodeOption
+	}{
+		{addr, 1, RoleAll, map[string]string{}, NodeOption{}},
+		{addr + ";weight=3", 3, RoleAll, map[string]string{}, NodeOption{}},
+		{addr + ";roles=read,write", 1, RoleRead | RoleWrite, map[string]string{}, NodeOption{}},
+		{addr + ";roles=read,archive", 1, RoleRead | RoleArchive, map[string]string{}, NodeOption{}},
+		{addr + ";role=notify", 1, RoleNotify, map[string]string{}, NodeOption{}},
+		{addr + "; weight = 2 ;label.region=eu;label.tier=hot", 2, RoleAll, map[string]string{"region": "eu", "tier": "hot"}, NodeOption{}},
+		{
+			addr + ";api-timeout=5s;relisten-min=1s;relisten-max=1m",
+			1, RoleAll, map[string]string{},
+			NodeOption{APITimeout: 5 * time.Second, ReListenMinInterval: time.Second, ReListenMaxInterval: time.Minute},
+		},
+	}
+
+	for _, c := range cases {
+		info, err := ParseNodeInfo(c.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.s, err)
+			continue
+		}
+
+		if string(info.Token) != "header.payload.sig" || info.Host != "127.0.0.1:1234" {
+			t.Errorf("%q: unexpected api info: token %q, host %q", c.s, info.Token, info.Host)
+		}
+
+		if info.Weight != c.weight {
+			t.Errorf("%q: expected weight %d, got %d", c.s, c.weight, info.Weight)
+		}
+
+		if info.Roles != c.roles {
+			t.Errorf("%q: expected roles %s, got %s", c.s, c.roles, info.Roles)
+		}
+
+		if len(info.Labels) != len(c.labels) {
+			t.Errorf("%q: expected labels %v, got %v", c.s, c.labels, info.Labels)
+		}
+
+		for k, v := range c.labels {
+			if info.Labels[k] != v {
+				t.Errorf("%q: expected label %s=%s, got %q", c.s, k, v, info.Labels[k])
+			}
+		}
+
+		if info.Opt != c.opt {
+			t.Errorf("%q: expected option %+v, got %+v", c.s, c.opt, info.Opt)
+		}
+	}
+}
+
+func TestParseNodeInfoInvalid(t *testing.T) {
+	const addr = "header.payload.sig:/ip4/127.0.0.1/tcp/1234/http"
+
+	cases := []string{
+		"header.payload.sig:%zz",
+		addr + ";weight",
+		addr + ";weight=0",
+		addr + ";weight=-1",
+		addr + ";weight=one",
+		addr + ";roles=",
+		addr + ";roles=sign",
+		addr + ";api-timeout=10",
+		addr + ";relisten-min=soon",
+		addr + ";color=red",
+	}
+
+	for _, s := range cases {
+		if _, err := ParseNodeInfo(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestNodeOptionMerge(t *testing.T) {
+	base := NodeOption{
+		ReListenMinInterval: time.Second,
+		ReListenMaxInterval: time.Minute,
+		APITimeout:          10 * time.Second,
+	}
+
+	merged := NodeOption{APITimeout: time.Second}.merge(base)
+	expected := NodeOption{
+		ReListenMinInterval: time.Second,
+		ReListenMaxInterval: time.Minute,
+		APITimeout:          time.Second,
+	}
+
+	if merged != expected {
+		t.Errorf("expected %+v, got %+v", expected, merged)
+	}
+
+	if merged := (NodeOption{}).merge(base); merged != base {
+		t.Errorf("expected the base %+v to be kept, got %+v", base, merged)
+	}
+}
@@ -0,0 +1,133 @@
+package co
+
+import (
+	"context"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// states of the upstream nodes
+const (
+	NodeStateConnecting = "connecting"
+	NodeStateListening  = "listening"
+	NodeStateRelisten   = "relistening"
+	NodeStateRefused    = "refused"
+	// NodeStateServing is for nodes without the notify role, which don't listen head changes
+	NodeStateServing = "serving"
+)
+
+// NodeStatus describes the current state of an upstream node
+type NodeStatus struct {
+	Host   string
+	Roles  string
+	Weight int
+	Labels map[string]string
+	State  string
+
+	Head       types.TipSetKey
+	Height     abi.ChainEpoch
+	HeadWeight types.BigInt
+
+	// HeadAt is the time when the latest head was reported
+	HeadAt time.Time
+
+	// Error is the last error we met with the node
+	Error string
+}
+
+// Healthy returns true if the node is listening head changes and has reported a head,
+// or it is only serving requests
+func (s NodeStatus) Healthy() bool {
+	return (s.State == NodeStateListening && s.Height > 0) || s.State == NodeStateServing
+}
+
+// Status returns the current status of the node
+func (n *Node) Status() NodeStatus {
+	n.status.RLock()
+	defer n.status.RUnlock()
+
+	st := NodeStatus{
+		Host:   n.info.Host,
+		Roles:  n.info.Roles.String(),
+		Weight: n.info.Weight,
+		Labels: n.info.Labels,
+		State:  NodeStateRelisten,
+		HeadAt: n.status.headAt,
+		Error:  n.status.lastErr,
+	}
+
+	switch {
+	case n.status.netErr != nil:
+		st.State = NodeStateRefused
+		st.Error = n.status.netErr.Error()
+
+	case !n.info.Roles.Has(RoleNotify):
+		st.State = NodeStateServing
+
+	case n.status.listening:
+		st.State = NodeStateListening
+	}
+
+	if head := n.status.head; head != nil {
+		st.Head = head.Key()
+		st.Height = head.Height()
+		st.HeadWeight = n.status.weight
+	}
+
+	return st
+}
+
+// onHead returns true if the latest head reported by the node is the given tipset
+func (n *Node) onHead(tsk types.TipSetKey) bool {
+	n.status.RLock()
+	defer n.status.RUnlock()
+	return n.status.head != nil && n.status.head.Key() == tsk
+}
+
+// onChain returns true if the given tipset is on the current chain of the node, the upstream node
+// is asked for the tipset and the one at the same height on its chain
+func (n *Node) onChain(ctx context.Context, tsk types.TipSetKey) bool {
+	if n.onHead(tsk) {
+		return true
+	}
+
+	callCtx, callCancel := context.WithTimeout(ctx, n.opt.APITimeout)
+	defer callCancel()
+
+	ts, err := n.upstream.full.ChainGetTipSet(callCtx, tsk)
+	if err != nil {
+		n.log.Debugf("tipset %s not found: %s", tsk, err)
+		return false
+	}
+
+	// the upstream head is used for the empty key
+	onChain, err := n.upstream.full.ChainGetTipSetByHeight(callCtx, ts.Height(), types.EmptyTSK)
+	if err != nil {
+		n.log.Debugf("tipset at %d not found: %s", ts.Height(), err)
+		return false
+	}
+
+	return onChain.Key() == tsk
+}
+
+func (n *Node) setListening(listening bool) {
+	n.status.Lock()
+	n.status.listening = listening
+	n.status.Unlock()
+}
+
+func (n *Node) setHead(ts *types.TipSet, weight types.BigInt) {
+	n.status.Lock()
+	n.status.head = ts
+	n.status.weight = weight
+	n.status.headAt = time.Now()
+	n.status.Unlock()
+}
+
+func (n *Node) setError(err error) {
+	n.status.Lock()
+	n.status.lastErr = err.Error()
+	n.status.Unlock()
+}
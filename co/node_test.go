@@ -0,0 +1,70 @@
+package co
+
+import (
+	"context"
+	"testing"
+
+	cliutil "github.com/filecoin-project/lotus/cli/util"
+
+	"github.com/dtynn/chain-co/proxy"
+)
+
+// newMockNode constructs a Node backed by a mock upstream, the loops of the node are not started
+func newMockNode(host string, roles NodeRole) (*Node, *proxy.MockFullNode) {
+	mock := proxy.NewMockFullNode()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	node := &Node{
+		opt: DefaultNodeOption(),
+		info: NodeInfo{
+			APIInfo: cliutil.APIInfo{Addr: host},
+			Host:    host,
+			Weight:  1,
+			Roles:   roles,
+		},
+		reListenInterval: DefaultNodeOption().ReListenMinInterval,
+		ctx:              ctx,
+		cancel:           cancel,
+		log:              log.With("remote", host),
+	}
+
+	node.pending.notify = make(chan struct{}, 1)
+	node.upstream.full = mock
+	node.upstream.closer = func() {}
+
+	return node, mock
+}
+
+// newMockSelector puts the nodes into a Selector without starting them,
+// priors are the hosts of the nodes on the best head
+func newMockSelector(t *testing.T, nodes []*Node, priors ...string) *Selector {
+	sel, err := NewSelector()
+	if err != nil {
+		t.Fatalf("construct selector: %s", err)
+	}
+
+	for _, node := range nodes {
+		sel.all.addrs = append(sel.all.addrs, node.info.Addr)
+		sel.all.nodes[node.info.Addr] = node
+	}
+
+	sel.setPriors(priors...)
+	return sel
+}
+
+func TestNodeUsable(t *testing.T) {
+	node, _ := newMockNode("a", RoleAll)
+	if !node.usable() {
+		t.Fatal("expected a new node to be usable")
+	}
+
+	node.status.netErr = ErrNetworkMismatch
+	if node.usable() {
+		t.Fatal("expected a node on another network to be refused")
+	}
+
+	sel := newMockSelector(t, []*Node{node})
+	if _, err := sel.Select(RoleRead); err != ErrNoNodeAvailable {
+		t.Fatalf("expected %s for the refused node, got %v", ErrNoNodeAvailable, err)
+	}
+}
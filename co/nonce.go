@@ -0,0 +1,143 @@
+package co
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// DefaultNonceOption returns default options
+func DefaultNonceOption() NonceOption {
+	return NonceOption{
+		Track: false,
+		TTL:   10 * time.Minute,
+	}
+}
+
+// NonceOption is for the local nonce tracker
+type NonceOption struct {
+	// Track enables tracking the nonces of the messages pushed through chain-co
+	Track bool
+
+	// TTL is how long a tracked nonce will be kept after the last push from the sender,
+	// in case that the messages are dropped by the upstream nodes
+	TTL time.Duration
+}
+
+type trackedNonce struct {
+	next     uint64
+	pushedAt time.Time
+}
+
+func newNonceTracker(opt NonceOption) *nonceTracker {
+	return &nonceTracker{
+		opt:    opt,
+		nonces: map[address.Address]trackedNonce{},
+	}
+}
+
+// nonceTracker records the next nonces of the senders whose messages are pushed through chain-co
+type nonceTracker struct {
+	opt NonceOption
+
+	sync.Mutex
+	nonces map[address.Address]trackedNonce
+}
+
+func (nt *nonceTracker) record(msgs ...*types.SignedMessage) {
+	if !nt.opt.Track {
+		return
+	}
+
+	now := time.Now()
+
+	nt.Lock()
+	defer nt.Unlock()
+
+	for _, msg := range msgs {
+		from := msg.Message.From
+		if tracked, ok := nt.nonces[from]; ok && tracked.next > msg.Message.Nonce && now.Sub(tracked.pushedAt) < nt.opt.TTL {
+			continue
+		}
+
+		nt.nonces[from] = trackedNonce{
+			next:     msg.Message.Nonce + 1,
+			pushedAt: now,
+		}
+	}
+}
+
+// next returns the tracked next nonce for the sender, false if not tracked or expired
+func (nt *nonceTracker) next(addr address.Address) (uint64, bool) {
+	if !nt.opt.Track {
+		return 0, false
+	}
+
+	nt.Lock()
+	defer nt.Unlock()
+
+	tracked, ok := nt.nonces[addr]
+	if !ok {
+		return 0, false
+	}
+
+	if time.Since(tracked.pushedAt) >= nt.opt.TTL {
+		delete(nt.nonces, addr)
+		return 0, false
+	}
+
+	return tracked.next, true
+}
+
+// TrackNonces wraps the given api.FullNode, the nonces of the successfully pushed messages will be tracked
+func (c *Coordinator) TrackNonces(full api.FullNode) api.FullNode {
+	if !c.ctx.nonces.opt.Track {
+		return full
+	}
+
+	return &nonceTrackedFullNode{
+		FullNode: full,
+		tracker:  c.ctx.nonces,
+	}
+}
+
+type nonceTrackedFullNode struct {
+	api.FullNode
+	tracker *nonceTracker
+}
+
+func (n *nonceTrackedFullNode) MpoolPush(ctx context.Context, smsg *types.SignedMessage) (cid.Cid, error) {
+	c, err := n.FullNode.MpoolPush(ctx, smsg)
+	if err == nil {
+		n.tracker.record(smsg)
+	}
+
+	return c, err
+}
+
+func (n *nonceTrackedFullNode) MpoolPushUntrusted(ctx context.Context, smsg *types.SignedMessage) (cid.Cid, error) {
+	c, err := n.FullNode.MpoolPushUntrusted(ctx, smsg)
+	if err == nil {
+		n.tracker.record(smsg)
+	}
+
+	return c, err
+}
+
+func (n *nonceTrackedFullNode) MpoolBatchPush(ctx context.Context, smsgs []*types.SignedMessage) ([]cid.Cid, error) {
+	cids, err := n.FullNode.MpoolBatchPush(ctx, smsgs)
+	n.tracker.record(smsgs[:len(cids)]...)
+	return cids, err
+}
+
+func (n *nonceTrackedFullNode) MpoolBatchPushUntrusted(ctx context.Context, smsgs []*types.SignedMessage) ([]cid.Cid, error) {
+	cids, err := n.FullNode.MpoolBatchPushUntrusted(ctx, smsgs)
+	n.tracker.record(smsgs[:len(cids)]...)
+	return cids, err
+}
@@ -0,0 +1,102 @@
+package co
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+func signedMsg(t *testing.T, from uint64, nonce uint64) *types.SignedMessage {
+	addr, err := address.NewIDAddress(from)
+	if err != nil {
+		t.Fatalf("construct address: %s", err)
+	}
+
+	return &types.SignedMessage{
+		Message: types.Message{
+			From:  addr,
+			Nonce: nonce,
+		},
+	}
+}
+
+func TestNonceTracker(t *testing.T) {
+	cases := []struct {
+		name   string
+		pushed []uint64
+		next   uint64
+	}{
+		{"single", []uint64{3}, 4},
+		{"increasing", []uint64{3, 4, 5}, 6},
+		{"lower nonce ignored", []uint64{5, 2}, 6},
+		{"same nonce", []uint64{5, 5}, 6},
+		{"gap", []uint64{1, 7}, 8},
+	}
+
+	for _, c := range cases {
+		nt := newNonceTracker(NonceOption{Track: true, TTL: time.Minute})
+
+		for _, nonce := range c.pushed {
+			nt.record(signedMsg(t, 1000, nonce))
+		}
+
+		next, ok := nt.next(signedMsg(t, 1000, 0).Message.From)
+		if !ok || next != c.next {
+			t.Errorf("%s: expected next nonce %d, got %d, %v", c.name, c.next, next, ok)
+		}
+
+		if _, ok := nt.next(signedMsg(t, 1001, 0).Message.From); ok {
+			t.Errorf("%s: expected untracked sender", c.name)
+		}
+	}
+}
+
+func TestNonceTrackerDisabled(t *testing.T) {
+	nt := newNonceTracker(NonceOption{Track: false, TTL: time.Minute})
+
+	msg := signedMsg(t, 1000, 3)
+	nt.record(msg)
+
+	if _, ok := nt.next(msg.Message.From); ok {
+		t.Error("expected nothing tracked when disabled")
+	}
+
+	if len(nt.nonces) != 0 {
+		t.Errorf("expected no nonces recorded, got %d", len(nt.nonces))
+	}
+}
+
+func TestNonceTrackerExpired(t *testing.T) {
+	nt := newNonceTracker(NonceOption{Track: true, TTL: time.Minute})
+
+	msg := signedMsg(t, 1000, 5)
+	from := msg.Message.From
+	nt.record(msg)
+
+	// pretend that the last push happened before the ttl
+	expire := func() {
+		nt.Lock()
+		tracked := nt.nonces[from]
+		tracked.pushedAt = tracked.pushedAt.Add(-2 * time.Minute)
+		nt.nonces[from] = tracked
+		nt.Unlock()
+	}
+
+	// a lower nonce replaces the expired one, e.g. after the messages were dropped by the upstream nodes
+	expire()
+	nt.record(signedMsg(t, 1000, 2))
+	if next, ok := nt.next(from); !ok || next != 3 {
+		t.Errorf("expected next nonce 3 after expiry, got %d, %v", next, ok)
+	}
+
+	expire()
+	if _, ok := nt.next(from); ok {
+		t.Error("expected the expired nonce to be dropped")
+	}
+
+	if _, ok := nt.nonces[from]; ok {
+		t.Error("expected the expired entry to be deleted")
+	}
+}
@@ -0,0 +1,286 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+// ErrRouteRejected is returned for the methods rejected by the routing table
+var ErrRouteRejected = fmt.Errorf("api not supported")
+
+// RoutePolicy decides how the requests of a method will be handled
+type RoutePolicy string
+
+// route policies
+const (
+	// RouteBest proxies the requests to a node on the best head with the specified role
+	RouteBest RoutePolicy = "best"
+	// RouteRole proxies the requests to a node with the specified role, nodes on the best head are preferred
+	RouteRole RoutePolicy = "role"
+	// RouteBroadcast sends the requests to all the nodes with the specified role, the first successful result is used
+	RouteBroadcast RoutePolicy = "broadcast"
+	// RouteReject rejects the requests
+	RouteReject RoutePolicy = "reject"
+)
+
+// Route maps the methods matching the pattern to a policy
+type Route struct {
+	// Pattern is either an exact method name, or a prefix ending with "*", e.g. "Mpool*"
+	Pattern string
+	Policy  RoutePolicy
+	Role    NodeRole
+}
+
+// Match returns true if the method matches the pattern
+func (r Route) Match(method string) bool {
+	if strings.HasSuffix(r.Pattern, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(r.Pattern, "*"))
+	}
+
+	return r.Pattern == method
+}
+
+// RouteTable is a type def for dependency injection
+type RouteTable []Route
+
+// NewRouter constructs a Router instance
+func NewRouter(sel *Selector, coordinator *Coordinator, table RouteTable, archiveOpt ArchiveOption, hedgeOpt HedgeOption, timeoutOpt TimeoutOption) (*Router, error) {
+	routes := make([]Route, 0, len(table))
+	for _, r := range table {
+		if r.Pattern == "" {
+			return nil, fmt.Errorf("empty route pattern")
+		}
+
+		if strings.Contains(strings.TrimSuffix(r.Pattern, "*"), "*") {
+			return nil, fmt.Errorf("invalid route pattern %q, wildcard is only allowed at the end", r.Pattern)
+		}
+
+		switch r.Policy {
+		case RouteBest, RouteRole, RouteBroadcast, RouteReject:
+
+		default:
+			return nil, fmt.Errorf("unknown policy %q for route %s", r.Policy, r.Pattern)
+		}
+
+		if r.Role == 0 {
+			r.Role = RoleRead
+		}
+
+		routes = append(routes, r)
+	}
+
+	// exact names go first, then the longer prefixes
+	sort.SliceStable(routes, func(i, j int) bool {
+		wi := strings.HasSuffix(routes[i].Pattern, "*")
+		wj := strings.HasSuffix(routes[j].Pattern, "*")
+		if wi != wj {
+			return !wi
+		}
+
+		return len(routes[i].Pattern) > len(routes[j].Pattern)
+	})
+
+	return &Router{
+		sel:         sel,
+		coordinator: coordinator,
+		routes:      routes,
+		archiveOpt:  archiveOpt,
+		hedger:      newHedger(hedgeOpt),
+		timeouts:    newTimeouts(timeoutOpt),
+	}, nil
+}
+
+// Router decides where the requests go according to the routing table
+type Router struct {
+	sel         *Selector
+	coordinator *Coordinator
+	routes      []Route
+	archiveOpt  ArchiveOption
+	hedger      *hedger
+	timeouts    *timeouts
+}
+
+// Match returns the route for the given method, false if no route matches
+func (r *Router) Match(method string) (Route, bool) {
+	for _, route := range r.routes {
+		if route.Match(method) {
+			return route, true
+		}
+	}
+
+	return Route{}, false
+}
+
+// MatchExact returns the route whose pattern is exactly the given method, false if there is none
+func (r *Router) MatchExact(method string) (Route, bool) {
+	for _, route := range r.routes {
+		if route.Pattern == method {
+			return route, true
+		}
+	}
+
+	return Route{}, false
+}
+
+// Select chooses the upstream for the given method and the params except the context,
+// the fallback route is used if no route matches.
+// The node pinned to the context by Call is used if there is one. Broadcast is done by Call,
+// Select only chooses one of the nodes for the calls made without it.
+func (r *Router) Select(ctx context.Context, method string, args []interface{}, fallback Route) (api.FullNode, error) {
+	if node, ok := pinnedUpstream(ctx); ok {
+		return node.FullNode(), nil
+	}
+
+	route := r.route(method, fallback)
+	switch route.Policy {
+	case RouteReject:
+		return nil, ErrRouteRejected
+
+	case RouteBroadcast:
+		nodes := r.sel.Candidates(route.Role)
+		if len(nodes) == 0 {
+			return nil, ErrNoNodeAvailable
+		}
+
+		return pickWeighted(nodes).FullNode(), nil
+	}
+
+	if archive := r.archiveFor(ctx, method, args, route); archive != nil {
+		return archive.FullNode(), nil
+	}
+
+	node, err := r.selectRole(route)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.FullNode(), nil
+}
+
+// Call runs the call of a proxied method with the configured timeout, the call is expected to choose
+// the upstream through Select. Hedged reads are pinned to a node, and made again on a second node
+// if the first one is slow, the results of the faster one are committed. Broadcast calls are pinned
+// to each of the nodes, the results of the first success are committed.
+func (r *Router) Call(ctx context.Context, method string, args []interface{}, fallback Route, call func(context.Context) (func(), error)) error {
+	ctx, cancel := r.WithTimeout(ctx, method)
+	defer cancel()
+
+	route := r.route(method, fallback)
+	if route.Policy == RouteBroadcast {
+		return broadcast(ctx, method, r.sel.Candidates(route.Role), call)
+	}
+
+	if !r.hedgeable(route, method) {
+		return callOnce(ctx, call)
+	}
+
+	if archive := r.archiveFor(ctx, method, args, route); archive != nil {
+		return callOnce(withUpstream(ctx, archive), call)
+	}
+
+	node, err := r.selectRole(route)
+	if err != nil {
+		return err
+	}
+
+	backup := r.backup(route, node)
+	if backup == nil {
+		return callOnce(withUpstream(ctx, node), call)
+	}
+
+	return r.hedger.run(ctx, method, node, backup, call)
+}
+
+func (r *Router) route(method string, fallback Route) Route {
+	if route, ok := r.Match(method); ok {
+		return route
+	}
+
+	return fallback
+}
+
+// hedgeable returns true if the requests of the method on the route can be hedged.
+// Only the reads are hedged, a write sent to two nodes would be applied twice, and methods
+// returning channels are never hedged, since cancelling the loser would close its channel.
+func (r *Router) hedgeable(route Route, method string) bool {
+	switch route.Policy {
+	case RouteBest, RouteRole:
+
+	default:
+		return false
+	}
+
+	return route.Role.Has(RoleRead) && !route.Role.Has(RoleWrite) && !chanMethods[method] && r.hedger.hedged(method)
+}
+
+// archiveFor chooses an archive node for the deep-history requests on the route, nil if not needed
+func (r *Router) archiveFor(ctx context.Context, method string, args []interface{}, route Route) *Node {
+	if r.archiveOpt.Horizon <= 0 || !route.Role.Has(RoleRead) || !isArchiveMethod(method) {
+		return nil
+	}
+
+	archives := r.sel.Candidates(RoleArchive)
+	if len(archives) == 0 {
+		return nil
+	}
+
+	return r.selectArchive(ctx, method, archives, args)
+}
+
+// selectRole chooses a node by the policy and the role of the route
+func (r *Router) selectRole(route Route) (*Node, error) {
+	if route.Policy == RouteBest {
+		return r.sel.SelectBest(route.Role)
+	}
+
+	return r.sel.Select(route.Role)
+}
+
+type upstreamKey struct{}
+
+// withUpstream pins the node to the context, Select will choose it for the calls made with the context
+func withUpstream(ctx context.Context, node *Node) context.Context {
+	return context.WithValue(ctx, upstreamKey{}, node)
+}
+
+func pinnedUpstream(ctx context.Context) (*Node, bool) {
+	node, ok := ctx.Value(upstreamKey{}).(*Node)
+	return node, ok
+}
+
+// callOnce runs the call and commits its results
+func callOnce(ctx context.Context, call func(context.Context) (func(), error)) error {
+	commit, err := call(ctx)
+	if commit != nil {
+		commit()
+	}
+
+	return err
+}
+
+// backup chooses another node for the hedged request, nil if there is none
+func (r *Router) backup(route Route, chosen *Node) *Node {
+	for _, onlyPriors := range []bool{true, false} {
+		if !onlyPriors && route.Policy == RouteBest {
+			break
+		}
+
+		candidates := r.sel.candidates(route.Role, onlyPriors)
+		others := make([]*Node, 0, len(candidates))
+		for _, node := range candidates {
+			if node != chosen {
+				others = append(others, node)
+			}
+		}
+
+		if len(others) > 0 {
+			return pickWeighted(others)
+		}
+	}
+
+	return nil
+}
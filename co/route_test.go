@@ -0,0 +1,207 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+
+	"github.com/dtynn/chain-co/proxy"
+)
+
+func TestRouterMatch(t *testing.T) {
+	table := RouteTable{
+		{Pattern: "State*", Policy: RouteRole},
+		{Pattern: "StateMarket*", Policy: RouteBest},
+		{Pattern: "StateMarketDeals", Policy: RouteReject},
+		{Pattern: "ChainHead", Policy: RouteBest, Role: RoleWrite},
+		{Pattern: "*", Policy: RouteBroadcast},
+	}
+
+	router, err := NewRouter(nil, nil, table, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	cases := []struct {
+		method  string
+		pattern string
+		exact   bool
+	}{
+		// exact names go before any prefix
+		{"StateMarketDeals", "StateMarketDeals", true},
+		// longer prefixes go before shorter ones
+		{"StateMarketBalance", "StateMarket*", false},
+		{"StateGetActor", "State*", false},
+		{"ChainHead", "ChainHead", true},
+		{"ChainHeadX", "*", false},
+		{"MpoolPush", "*", false},
+	}
+
+	for _, c := range cases {
+		route, ok := router.Match(c.method)
+		if !ok {
+			t.Errorf("%s: no route matched", c.method)
+			continue
+		}
+
+		if route.Pattern != c.pattern {
+			t.Errorf("%s: expected route %q, got %q", c.method, c.pattern, route.Pattern)
+		}
+
+		_, exact := router.MatchExact(c.method)
+		if exact != c.exact {
+			t.Errorf("%s: expected exact match %v, got %v", c.method, c.exact, exact)
+		}
+	}
+
+	if route, _ := router.Match("ChainHead"); route.Role != RoleWrite {
+		t.Errorf("expected the specified role to be kept, got %s", route.Role)
+	}
+
+	if route, _ := router.Match("StateGetActor"); route.Role != RoleRead {
+		t.Errorf("expected the read role by default, got %s", route.Role)
+	}
+}
+
+func TestRouterMatchNone(t *testing.T) {
+	router, err := NewRouter(nil, nil, RouteTable{{Pattern: "State*", Policy: RouteRole}}, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	for _, method := range []string{"ChainHead", "Stat", "state"} {
+		if route, ok := router.Match(method); ok {
+			t.Errorf("%s: expected no route, got %q", method, route.Pattern)
+		}
+	}
+}
+
+func TestNewRouterInvalid(t *testing.T) {
+	cases := []struct {
+		name  string
+		route Route
+	}{
+		{"empty pattern", Route{Policy: RouteRole}},
+		{"wildcard in the middle", Route{Pattern: "State*Actor", Policy: RouteRole}},
+		{"double wildcard", Route{Pattern: "State**", Policy: RouteRole}},
+		{"unknown policy", Route{Pattern: "State*", Policy: "random"}},
+	}
+
+	for _, c := range cases {
+		if _, err := NewRouter(nil, nil, RouteTable{c.route}, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption()); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
+
+func TestRouterSelectRole(t *testing.T) {
+	reader, rmock := newMockNode("reader", RoleRead)
+	writer, wmock := newMockNode("writer", RoleWrite)
+
+	rmock.StateGetActorFunc = func(context.Context, address.Address, types.TipSetKey) (*types.Actor, error) {
+		return &types.Actor{Nonce: 7}, nil
+	}
+
+	router, err := NewRouter(newMockSelector(t, []*Node{reader, writer}), nil, RouteTable{{Pattern: "State*", Policy: RouteRole}}, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	full, err := router.Select(context.Background(), "StateGetActor", []interface{}{address.Undef, types.EmptyTSK}, Route{Policy: RouteReject})
+	if err != nil {
+		t.Fatalf("select: %s", err)
+	}
+
+	actor, err := full.StateGetActor(context.Background(), address.Undef, types.EmptyTSK)
+	if err != nil {
+		t.Fatalf("call StateGetActor: %s", err)
+	}
+
+	if actor.Nonce != 7 {
+		t.Errorf("expected the actor from the read node, got nonce %d", actor.Nonce)
+	}
+
+	if calls := wmock.MockUnSupport.Calls(); len(calls) != 0 {
+		t.Errorf("expected no call on the write node, got %v", calls)
+	}
+
+	if _, err := router.Select(context.Background(), "ChainHead", nil, Route{Policy: RouteReject}); err != ErrRouteRejected {
+		t.Errorf("expected the fallback route to reject, got %v", err)
+	}
+
+	if _, err := router.Select(context.Background(), "ChainHead", nil, Route{Policy: RouteRole, Role: RoleNotify}); err != ErrNoNodeAvailable {
+		t.Errorf("expected %s without a notify node, got %v", ErrNoNodeAvailable, err)
+	}
+}
+
+func TestRouterCallBroadcast(t *testing.T) {
+	failing, fmock := newMockNode("failing", RoleWrite)
+	working, wmock := newMockNode("working", RoleWrite)
+
+	pushed, err := abi.CidBuilder.Sum([]byte("pushed"))
+	if err != nil {
+		t.Fatalf("build cid: %s", err)
+	}
+
+	called := make(chan struct{})
+	fmock.MpoolPushFunc = func(context.Context, *types.SignedMessage) (cid.Cid, error) {
+		defer close(called)
+		return cid.Undef, fmt.Errorf("mpool full")
+	}
+
+	// wait for the failing one, so that both of the calls are observed
+	wmock.MpoolPushFunc = func(context.Context, *types.SignedMessage) (cid.Cid, error) {
+		<-called
+		return pushed, nil
+	}
+
+	router, err := NewRouter(newMockSelector(t, []*Node{failing, working}), nil, RouteTable{{Pattern: "MpoolPush", Policy: RouteBroadcast, Role: RoleWrite}}, DefaultArchiveOption(), DefaultHedgeOption(), DefaultTimeoutOption())
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	fallback := Route{Policy: RouteReject}
+	writer := &proxy.WriteProxy{
+		Select: func(ctx context.Context, method string, args ...interface{}) (proxy.WriteProxyAPI, error) {
+			return router.Select(ctx, method, args, fallback)
+		},
+		Wrap: func(ctx context.Context, method string, args []interface{}, call func(context.Context) (func(), error)) error {
+			return router.Call(ctx, method, args, fallback, call)
+		},
+	}
+
+	c, err := writer.MpoolPush(context.Background(), &types.SignedMessage{})
+	if err != nil {
+		t.Fatalf("expected the successful result to be returned, got %s", err)
+	}
+
+	if c != pushed {
+		t.Errorf("expected the result of the working node, got %s", c)
+	}
+
+	for name, mock := range map[string]*proxy.MockFullNode{"failing": fmock, "working": wmock} {
+		if calls := mock.MockWriteProxy.CallsOf("MpoolPush"); len(calls) != 1 {
+			t.Errorf("%s: expected MpoolPush to be called once, got %d", name, len(calls))
+		}
+	}
+
+	reject := func(context.Context, *types.SignedMessage) (cid.Cid, error) {
+		return pushed, fmt.Errorf("mpool full")
+	}
+
+	fmock.MpoolPushFunc = reject
+	wmock.MpoolPushFunc = reject
+	c, err = writer.MpoolPush(context.Background(), &types.SignedMessage{})
+	if err == nil {
+		t.Fatal("expected an error if all the nodes failed")
+	}
+
+	if c.Defined() {
+		t.Errorf("expected no result to be committed if all the nodes failed, got %s", c)
+	}
+}
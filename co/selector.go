@@ -1,8 +1,11 @@
 package co
 
 import (
+	"context"
 	"math/rand"
 	"sync"
+
+	"github.com/filecoin-project/lotus/chain/types"
 )
 
 // NewSelector constructs a Selector instance
@@ -68,36 +71,143 @@ func (s *Selector) setPriors(addrs ...string) {
 	s.prior.Unlock()
 }
 
-// Select tries to choose a node from the candidates
-func (s *Selector) Select() (*Node, error) {
-	var addr string
+// Nodes returns all the nodes in use
+func (s *Selector) Nodes() []*Node {
+	s.all.RLock()
+	defer s.all.RUnlock()
+
+	nodes := make([]*Node, 0, len(s.all.addrs))
+	for _, addr := range s.all.addrs {
+		nodes = append(nodes, s.all.nodes[addr])
+	}
+
+	return nodes
+}
+
+func (s *Selector) node(addr string) (*Node, bool) {
+	s.all.RLock()
+	node, ok := s.all.nodes[addr]
+	s.all.RUnlock()
+	return node, ok
+}
+
+// Select tries to choose a node with the given role from the candidates,
+// the nodes on the best head are preferred
+func (s *Selector) Select(role NodeRole) (*Node, error) {
+	candidates := s.candidates(role, true)
+	if len(candidates) == 0 {
+		candidates = s.candidates(role, false)
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+
+	return pickWeighted(candidates), nil
+}
+
+// SelectBest tries to choose a node with the given role from the nodes on the best head
+func (s *Selector) SelectBest(role NodeRole) (*Node, error) {
+	candidates := s.candidates(role, true)
+	if len(candidates) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+
+	return pickWeighted(candidates), nil
+}
+
+// SelectOnChain tries to choose a node with the given role whose chain contains the specified tipset,
+// the nodes whose head is the tipset are preferred. ErrNoNodeOnTipSet is returned if none of the nodes is on it.
+func (s *Selector) SelectOnChain(ctx context.Context, role NodeRole, tsk types.TipSetKey) (*Node, error) {
+	candidates := s.candidates(role, false)
+	if len(candidates) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+
+	matched := make([]*Node, 0, len(candidates))
+	for _, node := range candidates {
+		if node.onHead(tsk) {
+			matched = append(matched, node)
+		}
+	}
 
-	s.prior.RLock()
-	psize := len(s.prior.addrs)
-	switch psize {
-	case 0:
+	if len(matched) > 0 {
+		return pickWeighted(matched), nil
+	}
 
-	case 1:
-		addr = s.prior.addrs[0]
+	oks := make([]bool, len(candidates))
+	var wg sync.WaitGroup
+	wg.Add(len(candidates))
+	for i := range candidates {
+		go func(i int) {
+			defer wg.Done()
+			oks[i] = candidates[i].onChain(ctx, tsk)
+		}(i)
+	}
+
+	wg.Wait()
 
-	default:
-		addr = s.prior.addrs[rand.Intn(psize)]
+	for i := range candidates {
+		if oks[i] {
+			matched = append(matched, candidates[i])
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil, ErrNoNodeOnTipSet
+	}
+
+	return pickWeighted(matched), nil
+}
+
+// Candidates returns all the usable nodes with the given role
+func (s *Selector) Candidates(role NodeRole) []*Node {
+	return s.candidates(role, false)
+}
+
+func (s *Selector) candidates(role NodeRole, onlyPriors bool) []*Node {
+	var addrs []string
+	if onlyPriors {
+		s.prior.RLock()
+		addrs = append(addrs, s.prior.addrs...)
+		s.prior.RUnlock()
 	}
-	s.prior.RUnlock()
 
 	s.all.RLock()
 	defer s.all.RUnlock()
 
-	if addr != "" {
-		if node, ok := s.all.nodes[addr]; ok {
-			return node, nil
+	if !onlyPriors {
+		addrs = s.all.addrs
+	}
+
+	nodes := make([]*Node, 0, len(addrs))
+	for _, addr := range addrs {
+		if node, ok := s.all.nodes[addr]; ok && node.usable() && node.info.Roles.Has(role) {
+			nodes = append(nodes, node)
 		}
 	}
 
-	allSize := len(s.all.addrs)
-	if allSize == 0 {
-		return nil, ErrNoNodeAvailable
+	return nodes
+}
+
+// pickWeighted chooses a node randomly, the chance is in proportion to the weight
+func pickWeighted(nodes []*Node) *Node {
+	total := 0
+	for _, node := range nodes {
+		total += node.info.Weight
+	}
+
+	if total <= 0 {
+		return nodes[rand.Intn(len(nodes))]
+	}
+
+	n := rand.Intn(total)
+	for _, node := range nodes {
+		n -= node.info.Weight
+		if n < 0 {
+			return node
+		}
 	}
 
-	return s.all.nodes[s.all.addrs[rand.Intn(allSize)]], nil
+	return nodes[len(nodes)-1]
 }
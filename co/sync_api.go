@@ -0,0 +1,158 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/metrics"
+)
+
+// SyncState impls api.FullNode.SyncState, each upstream node is reported as an active sync
+// targeting the best head. The syncs are never complete while the best head is stale.
+func (c *Coordinator) SyncState(context.Context) (*api.SyncState, error) {
+	c.headMu.RLock()
+	head, weight := c.head, c.weight
+	c.headMu.RUnlock()
+
+	if head == nil {
+		return nil, ErrHeadNotReady
+	}
+
+	drift := time.Since(time.Unix(int64(head.MinTimestamp()), 0))
+	stale := c.maxHeadDrift > 0 && drift > c.maxHeadDrift
+
+	nodes := c.sel.Nodes()
+	state := &api.SyncState{
+		ActiveSyncs: make([]api.ActiveSync, 0, len(nodes)),
+	}
+
+	for i := range nodes {
+		as := nodes[i].activeSync(uint64(i), head, weight)
+		if stale && as.Stage == api.StageSyncComplete {
+			as.Stage = api.StageHeaders
+			as.End = time.Time{}
+			as.Message = fmt.Sprintf("best head is stale, produced %s ago", drift.Truncate(time.Second))
+		}
+
+		state.ActiveSyncs = append(state.ActiveSyncs, as)
+	}
+
+	return state, nil
+}
+
+func (n *Node) activeSync(id uint64, target *types.TipSet, weight types.BigInt) api.ActiveSync {
+	n.status.RLock()
+	defer n.status.RUnlock()
+
+	as := api.ActiveSync{
+		WorkerID: id,
+		Base:     n.status.head,
+		Target:   target,
+		Start:    n.status.headAt,
+		Message:  n.status.lastErr,
+	}
+
+	switch {
+	case n.status.netErr != nil:
+		as.Stage = api.StageSyncErrored
+		as.Message = n.status.netErr.Error()
+
+	case n.status.head == nil:
+		as.Stage = api.StageIdle
+
+	case n.status.head.Equals(target) || !n.status.weight.LessThan(weight):
+		as.Stage = api.StageSyncComplete
+		as.Height = n.status.head.Height()
+		as.End = n.status.headAt
+
+	default:
+		as.Stage = api.StageHeaders
+		as.Height = n.status.head.Height()
+	}
+
+	return as
+}
+
+// SyncSubmitBlock impls api.FullNode.SyncSubmitBlock, the block will be submitted to all the healthy nodes,
+// it succeeds if any of them accepts the block
+func (c *Coordinator) SyncSubmitBlock(ctx context.Context, blk *types.BlockMsg) error {
+	nodes := c.submitNodes()
+	if len(nodes) == 0 {
+		return ErrNoNodeAvailable
+	}
+
+	errCh := make(chan error, len(nodes))
+	for i := range nodes {
+		go func(node *Node) {
+			errCh <- c.submitBlock(node, blk)
+		}(nodes[i])
+	}
+
+	var merr *multierror.Error
+	for range nodes {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errCh:
+			if err == nil {
+				return nil
+			}
+
+			merr = multierror.Append(merr, err)
+		}
+	}
+
+	return merr.ErrorOrNil()
+}
+
+// submitNodes returns the healthy nodes whatever their roles are, a block should reach the network
+// through as many nodes as possible. All the usable ones will be used if none of them is healthy.
+func (c *Coordinator) submitNodes() []*Node {
+	// every role has the empty role
+	candidates := c.sel.candidates(0, false)
+	nodes := make([]*Node, 0, len(candidates))
+	for i := range candidates {
+		if candidates[i].Status().Healthy() {
+			nodes = append(nodes, candidates[i])
+		}
+	}
+
+	if len(nodes) == 0 {
+		return candidates
+	}
+
+	return nodes
+}
+
+// submitBlock submits the block to the given node. The call is not bound to the request context,
+// so that the submissions keep going on after the first success returned to the miner.
+func (c *Coordinator) submitBlock(node *Node, blk *types.BlockMsg) error {
+	ctx, cancel := context.WithTimeout(c.ctx.lc, node.opt.APITimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := node.FullNode().SyncSubmitBlock(ctx, blk)
+
+	result := "ok"
+	if err != nil {
+		result = "failed"
+		node.log.Errorw("submit block failed", "height", blk.Header.Height, "miner", blk.Header.Miner, "blk", blk.Header.Cid(), "err", err)
+		err = fmt.Errorf("%s: %w", node.info.Host, err)
+	} else {
+		node.log.Infow("block submitted", "height", blk.Header.Height, "miner", blk.Header.Miner, "blk", blk.Header.Cid(), "elapsed", time.Since(start))
+	}
+
+	mctx, _ := tag.New(c.ctx.lc, tag.Upsert(metrics.Node, node.info.Host), tag.Upsert(metrics.Result, result))
+	stats.Record(mctx, metrics.BlockSubmitted.M(1))
+
+	return err
+}
@@ -0,0 +1,162 @@
+package co
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/dtynn/chain-co/fakenode"
+)
+
+func TestSyncSubmitBlockToHealthyNodes(t *testing.T) {
+	chain, err := fakenode.NewChain(1)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	ts, err := chain.Extend(chain.Genesis(), 0, 1, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	submitted := make(chan string, 3)
+	nodes := make([]*Node, 0, 3)
+	for host, roles := range map[string]NodeRole{"reader": RoleRead, "writer": RoleWrite, "notify": RoleNotify} {
+		node, mock := newMockNode(host, roles)
+		host := host
+		mock.SyncSubmitBlockFunc = func(context.Context, *types.BlockMsg) error {
+			submitted <- host
+			return nil
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the notify node is not listening yet, so it's not healthy
+	c, err := NewCoordinator(&Ctx{lc: ctx}, nil, types.NewInt(0), newMockSelector(t, nodes), nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	if err := c.SyncSubmitBlock(context.Background(), &types.BlockMsg{Header: ts.Blocks()[0]}); err != nil {
+		t.Fatalf("submit block: %s", err)
+	}
+
+	got := map[string]bool{}
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case host := <-submitted:
+			got[host] = true
+
+		case <-timeout:
+			t.Fatalf("expected the block to be submitted to the read and write nodes, got %v", got)
+		}
+	}
+
+	if !got["reader"] || !got["writer"] {
+		t.Errorf("expected the block to be submitted to the healthy nodes, got %v", got)
+	}
+
+	select {
+	case host := <-submitted:
+		t.Errorf("expected no submission to the unhealthy node, got %s", host)
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSyncState(t *testing.T) {
+	// the head at height 20 is produced right now
+	chain, err := fakenode.NewChain(20)
+	if err != nil {
+		t.Fatalf("construct chain: %s", err)
+	}
+
+	behind, err := chain.Extend(chain.Genesis(), 0, 10, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	head, err := chain.Extend(behind, 0, 10, 1)
+	if err != nil {
+		t.Fatalf("extend chain: %s", err)
+	}
+
+	synced, _ := newMockNode("synced", RoleAll)
+	synced.setHead(head, types.NewInt(20))
+
+	lagging, _ := newMockNode("lagging", RoleAll)
+	lagging.setHead(behind, types.NewInt(10))
+
+	idle, _ := newMockNode("idle", RoleAll)
+
+	refused, _ := newMockNode("refused", RoleAll)
+	refused.setHead(head, types.NewInt(20))
+	refused.status.netErr = ErrNetworkMismatch
+
+	sel := newMockSelector(t, []*Node{synced, lagging, idle, refused})
+
+	stages := func(maxHeadDrift time.Duration, head *types.TipSet) []api.SyncStateStage {
+		c, err := NewCoordinator(&Ctx{}, head, types.NewInt(uint64(head.Height())), sel, nil, maxHeadDrift)
+		if err != nil {
+			t.Fatalf("construct coordinator: %s", err)
+		}
+
+		state, err := c.SyncState(context.Background())
+		if err != nil {
+			t.Fatalf("get sync state: %s", err)
+		}
+
+		stages := make([]api.SyncStateStage, 0, len(state.ActiveSyncs))
+		for _, as := range state.ActiveSyncs {
+			if as.Target != head {
+				t.Errorf("expected the best head as the target of worker %d", as.WorkerID)
+			}
+
+			stages = append(stages, as.Stage)
+		}
+
+		return stages
+	}
+
+	expected := []api.SyncStateStage{api.StageSyncComplete, api.StageHeaders, api.StageIdle, api.StageSyncErrored}
+	if got := stages(time.Hour, head); !equalStages(got, expected) {
+		t.Errorf("expected stages %v, got %v", expected, got)
+	}
+
+	// the best head produced 10 epochs ago is stale, the nodes on it are never complete
+	expected = []api.SyncStateStage{api.StageHeaders, api.StageHeaders, api.StageIdle, api.StageSyncErrored}
+	if got := stages(time.Minute, behind); !equalStages(got, expected) {
+		t.Errorf("expected stages %v for the stale head, got %v", expected, got)
+	}
+
+	c, err := NewCoordinator(&Ctx{}, nil, types.NewInt(0), sel, nil, 0)
+	if err != nil {
+		t.Fatalf("construct coordinator: %s", err)
+	}
+
+	if _, err := c.SyncState(context.Background()); err != ErrHeadNotReady {
+		t.Errorf("expected %s without a head, got %v", ErrHeadNotReady, err)
+	}
+}
+
+func equalStages(a, b []api.SyncStateStage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
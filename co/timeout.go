@@ -0,0 +1,116 @@
+package co
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+// long-polling methods, which wait for something to happen on chain.
+// They are only limited by the timeouts configured with their exact names.
+var longPollMethods = map[string]bool{
+	"StateWaitMsg":        true,
+	"StateWaitMsgLimited": true,
+	"BeaconGetEntry":      true,
+}
+
+// DefaultTimeoutOption returns default options, no timeout is applied
+func DefaultTimeoutOption() TimeoutOption {
+	return TimeoutOption{}
+}
+
+// TimeoutOption is for the timeouts of the proxied calls
+type TimeoutOption struct {
+	// Default is applied to the methods without a specific timeout, zero means no timeout
+	Default time.Duration
+
+	// Methods maps the method names, or prefixes ending with "*", to the timeouts
+	Methods map[string]time.Duration
+}
+
+type methodTimeout struct {
+	pattern string
+	timeout time.Duration
+}
+
+func newTimeouts(opt TimeoutOption) *timeouts {
+	ts := &timeouts{
+		def:     opt.Default,
+		methods: make([]methodTimeout, 0, len(opt.Methods)),
+	}
+
+	for pattern, timeout := range opt.Methods {
+		ts.methods = append(ts.methods, methodTimeout{
+			pattern: pattern,
+			timeout: timeout,
+		})
+	}
+
+	// same precedence as the routes, exact names go first, then the longer prefixes
+	sort.Slice(ts.methods, func(i, j int) bool {
+		wi := strings.HasSuffix(ts.methods[i].pattern, "*")
+		wj := strings.HasSuffix(ts.methods[j].pattern, "*")
+		if wi != wj {
+			return !wi
+		}
+
+		return len(ts.methods[i].pattern) > len(ts.methods[j].pattern)
+	})
+
+	return ts
+}
+
+// timeouts decides the deadlines of the proxied calls
+type timeouts struct {
+	def     time.Duration
+	methods []methodTimeout
+}
+
+func (ts *timeouts) timeout(method string) time.Duration {
+	if longPollMethods[method] {
+		for _, mt := range ts.methods {
+			if mt.pattern == method {
+				return mt.timeout
+			}
+		}
+
+		return 0
+	}
+
+	for _, mt := range ts.methods {
+		if (Route{Pattern: mt.pattern}).Match(method) {
+			return mt.timeout
+		}
+	}
+
+	return ts.def
+}
+
+// chanMethods are the methods returning channels, they are not limited, since the channel would be closed by the deadline
+var chanMethods = func() map[string]bool {
+	methods := map[string]bool{}
+	typ := reflect.TypeOf((*api.FullNode)(nil)).Elem()
+	for i := 0; i < typ.NumMethod(); i++ {
+		mtyp := typ.Method(i).Type
+		if mtyp.NumOut() > 0 && mtyp.Out(0).Kind() == reflect.Chan {
+			methods[typ.Method(i).Name] = true
+		}
+	}
+
+	return methods
+}()
+
+// WithTimeout returns a context with the configured deadline of the method,
+// the context is returned as it is if the method is not limited
+func (r *Router) WithTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	timeout := r.timeouts.timeout(method)
+	if timeout <= 0 || chanMethods[method] {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
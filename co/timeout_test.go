@@ -0,0 +1,87 @@
+package co
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTimeoutPrecedence(t *testing.T) {
+	ts := newTimeouts(TimeoutOption{
+		Default: 30 * time.Second,
+		Methods: map[string]time.Duration{
+			"State*":         10 * time.Second,
+			"StateMarket*":   20 * time.Second,
+			"StateGetActor":  time.Second,
+			"Chain*":         5 * time.Second,
+			"StateWaitMsg":   time.Minute,
+			"BeaconGetEntr*": 2 * time.Second,
+		},
+	})
+
+	cases := []struct {
+		method  string
+		timeout time.Duration
+	}{
+		// exact names go before any prefix
+		{"StateGetActor", time.Second},
+		// longer prefixes go before shorter ones
+		{"StateMarketDeals", 20 * time.Second},
+		{"StateCall", 10 * time.Second},
+		{"ChainHead", 5 * time.Second},
+		{"MpoolPush", 30 * time.Second},
+		// long-polling methods are only limited by their exact names
+		{"StateWaitMsg", time.Minute},
+		{"StateWaitMsgLimited", 0},
+		{"BeaconGetEntry", 0},
+	}
+
+	for _, c := range cases {
+		if timeout := ts.timeout(c.method); timeout != c.timeout {
+			t.Errorf("%s: expected timeout %s, got %s", c.method, c.timeout, timeout)
+		}
+	}
+}
+
+func TestTimeoutDefault(t *testing.T) {
+	ts := newTimeouts(DefaultTimeoutOption())
+	for _, method := range []string{"ChainHead", "StateWaitMsg"} {
+		if timeout := ts.timeout(method); timeout != 0 {
+			t.Errorf("%s: expected no timeout by default, got %s", method, timeout)
+		}
+	}
+}
+
+func TestRouterWithTimeout(t *testing.T) {
+	router, err := NewRouter(nil, nil, nil, DefaultArchiveOption(), DefaultHedgeOption(), TimeoutOption{
+		Default: time.Minute,
+		Methods: map[string]time.Duration{
+			"MpoolPush": 0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("construct router: %s", err)
+	}
+
+	cases := []struct {
+		method   string
+		deadline bool
+	}{
+		{"ChainHead", true},
+		// disabled explicitly
+		{"MpoolPush", false},
+		// channels would be closed by the deadline
+		{"ChainNotify", false},
+		{"MpoolSub", false},
+		{"StateWaitMsg", false},
+	}
+
+	for _, c := range cases {
+		ctx, cancel := router.WithTimeout(context.Background(), c.method)
+		if _, ok := ctx.Deadline(); ok != c.deadline {
+			t.Errorf("%s: expected deadline %v, got %v", c.method, c.deadline, ok)
+		}
+
+		cancel()
+	}
+}
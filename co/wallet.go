@@ -0,0 +1,276 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"go.uber.org/fx"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/client"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/cli/util"
+)
+
+// ErrWalletNotConfigured is returned for the wallet methods if there is no wallet backend
+var ErrWalletNotConfigured = fmt.Errorf("wallet backend not configured")
+
+// DefaultWalletOption returns default options, no wallet backend is used
+func DefaultWalletOption() WalletOption {
+	return WalletOption{}
+}
+
+// WalletOption is for the wallet backend, at most one of Signer and Remote should be set
+type WalletOption struct {
+	// Signer is the api info of a dedicated lotus node, whose wallet will be used
+	Signer string
+
+	// Remote is the api info of a lotus-wallet instance
+	Remote string
+}
+
+// walletBackend is the subset of api.FullNode served by the wallet backend
+type walletBackend interface {
+	WalletNew(context.Context, types.KeyType) (address.Address, error)
+	WalletHas(context.Context, address.Address) (bool, error)
+	WalletList(context.Context) ([]address.Address, error)
+	WalletSign(context.Context, address.Address, []byte) (*crypto.Signature, error)
+	WalletSignMessage(context.Context, address.Address, *types.Message) (*types.SignedMessage, error)
+	WalletDefaultAddress(context.Context) (address.Address, error)
+	WalletSetDefault(context.Context, address.Address) error
+	WalletExport(context.Context, address.Address) (*types.KeyInfo, error)
+	WalletImport(context.Context, *types.KeyInfo) (address.Address, error)
+	WalletDelete(context.Context, address.Address) error
+}
+
+// NewWallet connects the configured wallet backend, the wallet methods will be rejected if none is configured
+func NewWallet(lc fx.Lifecycle, ctx *Ctx, opt WalletOption) (*Wallet, error) {
+	w := &Wallet{
+		// messages signed by chain-co are always tracked, so that the nonces won't be reused
+		// before the upstream nodes see the pushed messages
+		nonces: newNonceTracker(NonceOption{
+			Track: true,
+			TTL:   DefaultNonceOption().TTL,
+		}),
+	}
+
+	if opt.Signer != "" && opt.Remote != "" {
+		return nil, fmt.Errorf("both signer node and remote wallet are specified")
+	}
+
+	var closer jsonrpc.ClientCloser
+	switch {
+	case opt.Signer != "":
+		info := cliutil.ParseApiInfo(opt.Signer)
+		addr, err := info.DialArgs()
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer node: %w", err)
+		}
+
+		full, fcloser, err := client.NewFullNodeRPC(ctx.lc, addr, info.AuthHeader())
+		if err != nil {
+			return nil, fmt.Errorf("connect to signer node %s: %w", addr, err)
+		}
+
+		checkCtx, checkCancel := context.WithTimeout(ctx.lc, ctx.nodeOpt.APITimeout)
+		err = ctx.network.check(checkCtx, full)
+		checkCancel()
+
+		if err != nil {
+			fcloser()
+			return nil, fmt.Errorf("validate network for signer node %s: %w", addr, err)
+		}
+
+		w.backend = full
+		closer = fcloser
+		log.Infow("use signer node as wallet backend", "addr", addr)
+
+	case opt.Remote != "":
+		info := cliutil.ParseApiInfo(opt.Remote)
+		addr, err := info.DialArgs()
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote wallet: %w", err)
+		}
+
+		remote, rcloser, err := client.NewWalletRPC(ctx.lc, addr, info.AuthHeader())
+		if err != nil {
+			return nil, fmt.Errorf("connect to remote wallet %s: %w", addr, err)
+		}
+
+		w.backend = &remoteWallet{WalletAPI: remote}
+		closer = rcloser
+		log.Infow("use remote wallet as wallet backend", "addr", addr)
+
+	default:
+		return w, nil
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			closer()
+			return nil
+		},
+	})
+
+	return w, nil
+}
+
+// Wallet serves the wallet methods with the configured backend
+type Wallet struct {
+	backend walletBackend
+
+	// pushLk makes sure that the nonces are assigned one by one in MpoolPushMessage
+	pushLk sync.Mutex
+	nonces *nonceTracker
+}
+
+func (w *Wallet) use() (walletBackend, error) {
+	if w.backend == nil {
+		return nil, ErrWalletNotConfigured
+	}
+
+	return w.backend, nil
+}
+
+// WalletNew impls api.FullNode.WalletNew
+func (w *Wallet) WalletNew(ctx context.Context, typ types.KeyType) (address.Address, error) {
+	backend, err := w.use()
+	if err != nil {
+		return address.Undef, err
+	}
+
+	return backend.WalletNew(ctx, typ)
+}
+
+// WalletHas impls api.FullNode.WalletHas
+func (w *Wallet) WalletHas(ctx context.Context, addr address.Address) (bool, error) {
+	backend, err := w.use()
+	if err != nil {
+		return false, err
+	}
+
+	return backend.WalletHas(ctx, addr)
+}
+
+// WalletList impls api.FullNode.WalletList
+func (w *Wallet) WalletList(ctx context.Context) ([]address.Address, error) {
+	backend, err := w.use()
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.WalletList(ctx)
+}
+
+// WalletSign impls api.FullNode.WalletSign
+func (w *Wallet) WalletSign(ctx context.Context, addr address.Address, data []byte) (*crypto.Signature, error) {
+	backend, err := w.use()
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.WalletSign(ctx, addr, data)
+}
+
+// WalletSignMessage impls api.FullNode.WalletSignMessage
+func (w *Wallet) WalletSignMessage(ctx context.Context, addr address.Address, msg *types.Message) (*types.SignedMessage, error) {
+	backend, err := w.use()
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.WalletSignMessage(ctx, addr, msg)
+}
+
+// WalletDefaultAddress impls api.FullNode.WalletDefaultAddress
+func (w *Wallet) WalletDefaultAddress(ctx context.Context) (address.Address, error) {
+	backend, err := w.use()
+	if err != nil {
+		return address.Undef, err
+	}
+
+	return backend.WalletDefaultAddress(ctx)
+}
+
+// WalletSetDefault impls api.FullNode.WalletSetDefault
+func (w *Wallet) WalletSetDefault(ctx context.Context, addr address.Address) error {
+	backend, err := w.use()
+	if err != nil {
+		return err
+	}
+
+	return backend.WalletSetDefault(ctx, addr)
+}
+
+// WalletExport impls api.FullNode.WalletExport
+func (w *Wallet) WalletExport(ctx context.Context, addr address.Address) (*types.KeyInfo, error) {
+	backend, err := w.use()
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.WalletExport(ctx, addr)
+}
+
+// WalletImport impls api.FullNode.WalletImport
+func (w *Wallet) WalletImport(ctx context.Context, ki *types.KeyInfo) (address.Address, error) {
+	backend, err := w.use()
+	if err != nil {
+		return address.Undef, err
+	}
+
+	return backend.WalletImport(ctx, ki)
+}
+
+// WalletDelete impls api.FullNode.WalletDelete
+func (w *Wallet) WalletDelete(ctx context.Context, addr address.Address) error {
+	backend, err := w.use()
+	if err != nil {
+		return err
+	}
+
+	return backend.WalletDelete(ctx, addr)
+}
+
+// remoteWallet adapts a lotus-wallet to the wallet methods of api.FullNode
+type remoteWallet struct {
+	api.WalletAPI
+}
+
+func (rw *remoteWallet) WalletSign(ctx context.Context, addr address.Address, data []byte) (*crypto.Signature, error) {
+	return rw.WalletAPI.WalletSign(ctx, addr, data, api.MsgMeta{
+		Type: api.MTUnknown,
+	})
+}
+
+func (rw *remoteWallet) WalletSignMessage(ctx context.Context, addr address.Address, msg *types.Message) (*types.SignedMessage, error) {
+	mb, err := msg.ToStorageBlock()
+	if err != nil {
+		return nil, fmt.Errorf("serializing message: %w", err)
+	}
+
+	sig, err := rw.WalletAPI.WalletSign(ctx, addr, mb.Cid().Bytes(), api.MsgMeta{
+		Type:  api.MTChainMsg,
+		Extra: mb.RawData(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	return &types.SignedMessage{
+		Message:   *msg,
+		Signature: *sig,
+	}, nil
+}
+
+func (rw *remoteWallet) WalletDefaultAddress(context.Context) (address.Address, error) {
+	return address.Undef, fmt.Errorf("default address is not supported by the remote wallet")
+}
+
+func (rw *remoteWallet) WalletSetDefault(context.Context, address.Address) error {
+	return fmt.Errorf("default address is not supported by the remote wallet")
+}
@@ -0,0 +1,191 @@
+package fakenode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+)
+
+// BlockDelay is the distance between the timestamps of two adjacent epochs
+const BlockDelay = 30 * time.Second
+
+// NewChain constructs a Chain with only the genesis tipset.
+// The genesis timestamp is set so that a head at the given height would be produced right now.
+func NewChain(height abi.ChainEpoch) (*Chain, error) {
+	placeholder, err := abi.CidBuilder.Sum([]byte("fakenode"))
+	if err != nil {
+		return nil, fmt.Errorf("build placeholder cid: %w", err)
+	}
+
+	c := &Chain{
+		placeholder: placeholder,
+		genesisAt:   uint64(time.Now().Add(-time.Duration(height) * BlockDelay).Unix()),
+		tipsets:     map[types.TipSetKey]*types.TipSet{},
+		blocks:      map[cid.Cid]*types.BlockHeader{},
+		weights:     map[types.TipSetKey]types.BigInt{},
+	}
+
+	genesis, err := c.add(nil, 0, 0, types.NewInt(0), types.NewInt(1))
+	if err != nil {
+		return nil, fmt.Errorf("build genesis: %w", err)
+	}
+
+	c.genesis = genesis
+	return c, nil
+}
+
+// Chain is a synthetic chain made of single-block tipsets, forks can be built on any of the tipsets
+type Chain struct {
+	placeholder cid.Cid
+	genesisAt   uint64
+	genesis     *types.TipSet
+
+	sync.RWMutex
+	tipsets map[types.TipSetKey]*types.TipSet
+	blocks  map[cid.Cid]*types.BlockHeader
+	weights map[types.TipSetKey]types.BigInt
+}
+
+// Genesis returns the genesis tipset
+func (c *Chain) Genesis() *types.TipSet {
+	return c.genesis
+}
+
+// Extend builds n tipsets on top of the parent, and returns the last one.
+// Tipsets on different branches are mined by different miners, so the same heights won't collide.
+// Each tipset adds weightPerEpoch to the weight of its parent.
+func (c *Chain) Extend(parent *types.TipSet, branch uint64, n int, weightPerEpoch int64) (*types.TipSet, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("expected at least 1 tipset, got %d", n)
+	}
+
+	if weightPerEpoch <= 0 {
+		return nil, fmt.Errorf("weight per epoch should be positive")
+	}
+
+	head := parent
+	for i := 0; i < n; i++ {
+		pweight, err := c.Weight(head.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		ts, err := c.add(head, head.Height()+1, branch, pweight, types.BigAdd(pweight, types.NewInt(uint64(weightPerEpoch))))
+		if err != nil {
+			return nil, fmt.Errorf("build tipset at %d: %w", head.Height()+1, err)
+		}
+
+		head = ts
+	}
+
+	return head, nil
+}
+
+func (c *Chain) add(parent *types.TipSet, height abi.ChainEpoch, branch uint64, pweight, weight types.BigInt) (*types.TipSet, error) {
+	miner, err := address.NewIDAddress(1000 + branch)
+	if err != nil {
+		return nil, err
+	}
+
+	blk := &types.BlockHeader{
+		Miner: miner,
+		Ticket: &types.Ticket{
+			VRFProof: []byte(fmt.Sprintf("fakenode/%d/%d", branch, height)),
+		},
+		Height:                height,
+		ParentWeight:          pweight,
+		ParentStateRoot:       c.placeholder,
+		ParentMessageReceipts: c.placeholder,
+		Messages:              c.placeholder,
+		Timestamp:             c.genesisAt + uint64(height)*uint64(BlockDelay/time.Second),
+		ParentBaseFee:         types.NewInt(100),
+	}
+
+	if parent != nil {
+		blk.Parents = parent.Cids()
+	}
+
+	ts, err := types.NewTipSet([]*types.BlockHeader{blk})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	c.tipsets[ts.Key()] = ts
+	c.blocks[blk.Cid()] = blk
+	c.weights[ts.Key()] = weight
+	c.Unlock()
+
+	return ts, nil
+}
+
+// TipSet returns the tipset with the given key
+func (c *Chain) TipSet(tsk types.TipSetKey) (*types.TipSet, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	ts, ok := c.tipsets[tsk]
+	if !ok {
+		return nil, fmt.Errorf("tipset %s not found", tsk)
+	}
+
+	return ts, nil
+}
+
+// Block returns the block header with the given cid
+func (c *Chain) Block(bcid cid.Cid) (*types.BlockHeader, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	blk, ok := c.blocks[bcid]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", bcid)
+	}
+
+	return blk, nil
+}
+
+// Weight returns the weight of the tipset with the given key
+func (c *Chain) Weight(tsk types.TipSetKey) (types.BigInt, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	w, ok := c.weights[tsk]
+	if !ok {
+		return types.BigInt{}, fmt.Errorf("tipset %s not found", tsk)
+	}
+
+	return w, nil
+}
+
+// Changes returns the head changes from one tipset to another, in the order of lotus' ChainNotify
+func (c *Chain) Changes(from, to *types.TipSet) ([]*api.HeadChange, error) {
+	revert, apply, err := store.ReorgOps(c.TipSet, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*api.HeadChange, 0, len(revert)+len(apply))
+	for i := range revert {
+		changes = append(changes, &api.HeadChange{
+			Type: store.HCRevert,
+			Val:  revert[i],
+		})
+	}
+
+	for i := len(apply) - 1; i >= 0; i-- {
+		changes = append(changes, &api.HeadChange{
+			Type: store.HCApply,
+			Val:  apply[i],
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,168 @@
+package fakenode
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+
+	"github.com/dtynn/chain-co/proxy"
+)
+
+// NetworkName is the network name reported by the fake nodes
+const NetworkName dtypes.NetworkName = "fakenode"
+
+// New constructs a fake lotus node on the given chain, and serves it over go-jsonrpc.
+// The chain methods used by chain-co are faked with the chain, others can be scripted
+// through the embedded MockFullNode.
+func New(chain *Chain, head *types.TipSet) *Node {
+	n := &Node{
+		MockFullNode: proxy.NewMockFullNode(),
+		chain:        chain,
+		head:         head,
+		subs:         map[chan []*api.HeadChange]context.Context{},
+	}
+
+	n.ChainHeadFunc = n.chainHead
+	n.ChainTipSetWeightFunc = n.chainTipSetWeight
+	n.ChainGetBlockFunc = n.chainGetBlock
+	n.ChainGetGenesisFunc = n.chainGetGenesis
+	n.ChainNotifyFunc = n.chainNotify
+	n.MpoolSubFunc = n.mpoolSub
+	n.StateNetworkNameFunc = n.stateNetworkName
+
+	rpcServer := jsonrpc.NewServer()
+	rpcServer.Register("Filecoin", n.MockFullNode)
+
+	mux := http.NewServeMux()
+	mux.Handle("/rpc/v0", rpcServer)
+	n.srv = httptest.NewServer(mux)
+
+	return n
+}
+
+// Node is a fake lotus node
+type Node struct {
+	*proxy.MockFullNode
+
+	chain *Chain
+	srv   *httptest.Server
+
+	// mu guards the head and the subscribers, so that the changes are delivered in order
+	mu   sync.Mutex
+	head *types.TipSet
+	subs map[chan []*api.HeadChange]context.Context
+}
+
+// APIInfo returns the api info of the node, in the form accepted by co.ParseNodeInfo
+func (n *Node) APIInfo() string {
+	u, err := url.Parse(n.srv.URL)
+	if err != nil {
+		panic(fmt.Errorf("parse server url %s: %w", n.srv.URL, err))
+	}
+
+	return fmt.Sprintf("/ip4/%s/tcp/%s/http", u.Hostname(), u.Port())
+}
+
+// Head returns the current head of the node
+func (n *Node) Head() *types.TipSet {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.head
+}
+
+// SetHead moves the head of the node to the given tipset, the revert and apply changes
+// will be sent to the ChainNotify subscribers
+func (n *Node) SetHead(ts *types.TipSet) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	changes, err := n.chain.Changes(n.head, ts)
+	if err != nil {
+		return fmt.Errorf("compute head changes: %w", err)
+	}
+
+	n.head = ts
+	if len(changes) == 0 {
+		return nil
+	}
+
+	for ch, ctx := range n.subs {
+		select {
+		case ch <- changes:
+
+		case <-ctx.Done():
+
+		}
+	}
+
+	return nil
+}
+
+// Close stops serving the node
+func (n *Node) Close() {
+	n.srv.Close()
+}
+
+func (n *Node) chainHead(context.Context) (*types.TipSet, error) {
+	return n.Head(), nil
+}
+
+func (n *Node) chainTipSetWeight(_ context.Context, tsk types.TipSetKey) (types.BigInt, error) {
+	return n.chain.Weight(tsk)
+}
+
+func (n *Node) chainGetBlock(_ context.Context, bcid cid.Cid) (*types.BlockHeader, error) {
+	return n.chain.Block(bcid)
+}
+
+func (n *Node) chainGetGenesis(context.Context) (*types.TipSet, error) {
+	return n.chain.Genesis(), nil
+}
+
+func (n *Node) stateNetworkName(context.Context) (dtypes.NetworkName, error) {
+	return NetworkName, nil
+}
+
+func (n *Node) chainNotify(ctx context.Context) (<-chan []*api.HeadChange, error) {
+	// buffered, so that SetHead won't be blocked by the subscribers in most cases
+	ch := make(chan []*api.HeadChange, 16)
+
+	n.mu.Lock()
+	ch <- []*api.HeadChange{{
+		Type: store.HCCurrent,
+		Val:  n.head,
+	}}
+	n.subs[ch] = ctx
+	n.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		n.mu.Lock()
+		delete(n.subs, ch)
+		close(ch)
+		n.mu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+func (n *Node) mpoolSub(ctx context.Context) (<-chan api.MpoolUpdate, error) {
+	ch := make(chan api.MpoolUpdate)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+
+	return ch, nil
+}
@@ -3,6 +3,8 @@ module github.com/dtynn/chain-co
 go 1.15
 
 require (
+	contrib.go.opencensus.io/exporter/prometheus v0.1.0
+	github.com/BurntSushi/toml v0.3.1
 	github.com/dtynn/dix v0.1.0
 	github.com/filecoin-project/go-address v0.0.5
 	github.com/filecoin-project/go-bitfield v0.2.4
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// tags
+var (
+	Node, _   = tag.NewKey("node")
+	Result, _ = tag.NewKey("result")
+	Method, _ = tag.NewKey("method")
+)
+
+// measures
+var (
+	ForkDetected = stats.Int64("chainco/fork_detected", "Counter of forks detected between upstream nodes", stats.UnitDimensionless)
+	ForkDepth    = stats.Int64("chainco/fork_depth", "Distance from the best head to the fork point of an upstream node", stats.UnitDimensionless)
+	ForkedNodes  = stats.Int64("chainco/forked_nodes", "Number of upstream nodes on a different branch from the best head", stats.UnitDimensionless)
+
+	BlockSubmitted = stats.Int64("chainco/block_submitted", "Counter of blocks submitted to upstream nodes", stats.UnitDimensionless)
+
+	APIRequestDuration = stats.Float64("chainco/api_request_duration_ms", "Duration of the api requests", stats.UnitMilliseconds)
+)
+
+// views
+var (
+	ForkDetectedView = &view.View{
+		Measure:     ForkDetected,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{Node},
+	}
+	ForkDepthView = &view.View{
+		Measure:     ForkDepth,
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{Node},
+	}
+	ForkedNodesView = &view.View{
+		Measure:     ForkedNodes,
+		Aggregation: view.LastValue(),
+	}
+	BlockSubmittedView = &view.View{
+		Measure:     BlockSubmitted,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{Node, Result},
+	}
+	APIRequestDurationView = &view.View{
+		Measure:     APIRequestDuration,
+		Aggregation: view.Distribution(1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000, 30000, 60000),
+		TagKeys:     []tag.Key{Method, Result},
+	}
+)
+
+// DefaultViews is an array of OpenCensus views for metric gathering purposes
+var DefaultViews = []*view.View{
+	ForkDetectedView,
+	ForkDepthView,
+	ForkedNodesView,
+	BlockSubmittedView,
+	APIRequestDurationView,
+}
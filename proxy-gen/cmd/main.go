@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/urfave/cli/v2"
+
+	"github.com/dtynn/chain-co/api"
+	"github.com/dtynn/chain-co/proxy-gen"
+)
+
+type apiDef struct {
+	api      interface{}
+	handling string
+}
+
+// interfaces that can be generated, keyed by the names in the targets
+var interfaces = map[string]apiDef{
+	"Proxy": {
+		api:      (*api.Proxy)(nil),
+		handling: "Proxied to an upstream node with the read role, nodes on the best head are preferred.",
+	},
+	"WriteProxy": {
+		api:      (*api.WriteProxy)(nil),
+		handling: "Proxied to an upstream node with the write role.",
+	},
+	"Local": {
+		api:      (*api.Local)(nil),
+		handling: "Served by chain-co, based on the states of the upstream nodes.",
+	},
+	"UnSupport": {
+		api:      (*api.UnSupport)(nil),
+		handling: "Rejected, unless routed to the upstream nodes by the routing table.",
+	},
+}
+
+// permissions of the methods in the embedded api.Common, which can't be annotated in api/api.go
+var commonPerms = map[string]string{
+	"AuthVerify":                  "read",
+	"AuthNew":                     "admin",
+	"NetConnectedness":            "read",
+	"NetPeers":                    "read",
+	"NetConnect":                  "write",
+	"NetAddrsListen":              "read",
+	"NetDisconnect":               "write",
+	"NetFindPeer":                 "read",
+	"NetPubsubScores":             "read",
+	"NetAutoNatStatus":            "read",
+	"NetAgentVersion":             "read",
+	"NetPeerInfo":                 "read",
+	"NetBandwidthStats":           "read",
+	"NetBandwidthStatsByPeer":     "read",
+	"NetBandwidthStatsByProtocol": "read",
+	"NetBlockAdd":                 "admin",
+	"NetBlockRemove":              "admin",
+	"NetBlockList":                "read",
+	"ID":                          "read",
+	"Version":                     "read",
+	"LogList":                     "write",
+	"LogSetLevel":                 "write",
+	"Shutdown":                    "admin",
+	"Session":                     "read",
+	"Closing":                     "read",
+}
+
+// used if no target is specified
+var defaultTargets = []string{
+	"Proxy:Proxy:./proxy/proxy.go",
+	"WriteProxy:WriteProxy:./proxy/write_proxy.go",
+	"Local:Local:./proxy/local.go",
+	"UnSupport:UnSupport:./proxy/unsupport.go",
+}
+
+type target struct {
+	iface      string
+	structName string
+	outPath    string
+}
+
+func parseTarget(s string) (target, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return target{}, fmt.Errorf("invalid target %q, expected <interface>:<struct>:<output>", s)
+	}
+
+	t := target{
+		iface:      parts[0],
+		structName: parts[1],
+		outPath:    parts[2],
+	}
+
+	if _, ok := interfaces[t.iface]; !ok {
+		known := make([]string, 0, len(interfaces))
+		for name := range interfaces {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+
+		return target{}, fmt.Errorf("unknown interface %q, available: %s", t.iface, strings.Join(known, ", "))
+	}
+
+	return t, nil
+}
+
+func main() {
+	app := &cli.App{
+		Name:      "proxy-gen",
+		Usage:     "generate the proxy implementations for the api interfaces",
+		ArgsUsage: "[<interface>:<struct>:<output> ...]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "pkg",
+				Usage: "package name of the generated code",
+				Value: "proxy",
+			},
+			&cli.BoolFlag{
+				Name:  "check",
+				Usage: "fail if the generated code differs from the existing files, instead of writing them",
+			},
+			&cli.StringFlag{
+				Name:  "api",
+				Usage: "source file of the api interfaces, the `//perm:<perm>` annotations are read from it",
+				Value: "./api/api.go",
+			},
+			&cli.BoolFlag{
+				Name:  "mock",
+				Usage: "also generate the mock implementations, in <output>_mock.go",
+				Value: true,
+			},
+			&cli.StringFlag{
+				Name:  "doc",
+				Usage: "output path of the Markdown method table, empty to skip",
+				Value: "./docs/methods.md",
+			},
+		},
+		Action: run,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, "ERR:", err)
+		os.Exit(1)
+	}
+}
+
+func run(cctx *cli.Context) error {
+	raws := cctx.Args().Slice()
+	if len(raws) == 0 {
+		raws = defaultTargets
+	}
+
+	targets := make([]target, 0, len(raws))
+	for _, raw := range raws {
+		t, err := parseTarget(raw)
+		if err != nil {
+			return err
+		}
+
+		targets = append(targets, t)
+	}
+
+	perms, err := gen.ParsePerms(cctx.String("api"))
+	if err != nil {
+		return fmt.Errorf("parse permissions: %w", err)
+	}
+
+	for name, perm := range commonPerms {
+		perms[name] = perm
+	}
+
+	// the permissions should be the same as what lotus requires
+	if err := gen.ComparePerms(perms, gen.StructPerms(&apistruct.FullNodeStruct{})); err != nil {
+		return err
+	}
+
+	pkgName := cctx.String("pkg")
+	out := &output{
+		check: cctx.Bool("check"),
+	}
+
+	groups := make([]gen.DocGroup, 0, len(targets))
+	for _, t := range targets {
+		def := interfaces[t.iface]
+		code, err := gen.Gen(pkgName, t.structName, def.api, perms)
+		if err != nil {
+			return fmt.Errorf("generate %s for %s: %w", t.structName, t.iface, err)
+		}
+
+		if err := out.write(t.outPath, code); err != nil {
+			return err
+		}
+
+		if cctx.Bool("mock") {
+			mock, err := gen.GenMock(pkgName, t.structName, def.api)
+			if err != nil {
+				return fmt.Errorf("generate mock for %s: %w", t.structName, err)
+			}
+
+			if err := out.write(strings.TrimSuffix(t.outPath, ".go")+"_mock.go", mock); err != nil {
+				return err
+			}
+		}
+
+		groups = append(groups, gen.DocGroup{
+			Name:     t.structName,
+			Handling: def.handling,
+			API:      def.api,
+		})
+	}
+
+	if docPath := cctx.String("doc"); docPath != "" {
+		doc, err := gen.Doc("API Methods", groups, perms)
+		if err != nil {
+			return fmt.Errorf("generate doc: %w", err)
+		}
+
+		if err := out.write(docPath, doc); err != nil {
+			return err
+		}
+	}
+
+	if len(out.outdated) > 0 {
+		return fmt.Errorf("generated code is out of date: %s", strings.Join(out.outdated, ", "))
+	}
+
+	return nil
+}
+
+// output writes the generated files, or compares them with the existing ones in check mode
+type output struct {
+	check    bool
+	outdated []string
+}
+
+func (o *output) write(path string, content []byte) error {
+	if !o.check {
+		return ioutil.WriteFile(path, content, 0644)
+	}
+
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if !bytes.Equal(existing, content) {
+		o.outdated = append(o.outdated, path)
+	}
+
+	return nil
+}
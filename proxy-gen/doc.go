@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DocGroup describes how the methods of an api interface are handled
+type DocGroup struct {
+	Name     string
+	Handling string
+	API      interface{}
+}
+
+// Doc generates a Markdown document listing the methods of the given groups, with the permissions if known
+func Doc(title string, groups []DocGroup, perms map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("# %s\n\n", title))
+	buf.WriteString("<!-- Code generated by proxy-gen. DO NOT EDIT. -->\n\n")
+
+	buf.WriteString("| Group | Methods | Handling |\n")
+	buf.WriteString("| --- | --- | --- |\n")
+	for _, group := range groups {
+		typ, err := apiType(group.API)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", group.Name, err)
+		}
+
+		buf.WriteString(fmt.Sprintf("| [%s](#%s) | %d | %s |\n", group.Name, strings.ToLower(group.Name), typ.NumMethod(), group.Handling))
+	}
+
+	for _, group := range groups {
+		typ, _ := apiType(group.API)
+
+		buf.WriteString(fmt.Sprintf("\n## %s\n\n", group.Name))
+		buf.WriteString(fmt.Sprintf("%s\n\n", group.Handling))
+		buf.WriteString("| Method | Permission |\n")
+		buf.WriteString("| --- | --- |\n")
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := typ.Method(i).Name
+			perm := perms[name]
+			if perm == "" {
+				perm = "-"
+			}
+
+			buf.WriteString(fmt.Sprintf("| %s | %s |\n", name, perm))
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func apiType(api interface{}) (reflect.Type, error) {
+	raw := reflect.TypeOf(api)
+	if raw.Kind() != reflect.Ptr || raw.Elem().Kind() != reflect.Interface {
+		return nil, fmt.Errorf("api should be a ptr to an interface, got %s", raw)
+	}
+
+	return raw.Elem(), nil
+}
@@ -2,22 +2,36 @@ package gen
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"go/build"
 	"go/format"
 	"reflect"
+	"sort"
 	"strings"
 )
 
 var errType = reflect.TypeOf((*error)(nil)).Elem()
+var ctxType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Gen generates the impl code for given api interface.
+// If perms is not nil, every method should have a permission, and a <struct>Perms map will be generated.
+func Gen(pkgName, structName string, api interface{}, perms map[string]string) ([]byte, error) {
+	gen := newGenerator(pkgName, structName, perms)
+
+	// context is always used in the definition of the Wrap hook
+	if _, err := gen.getDepDef("context"); err != nil {
+		return nil, err
+	}
 
-// Gen generates the impl code for given api interface
-func Gen(pkgName, structName string, api interface{}) ([]byte, error) {
-	gen := newGenerator(pkgName, structName)
 	if err := gen.register(reflect.TypeOf(api)); err != nil {
 		return nil, err
 	}
 
+	if err := gen.checkPerms(); err != nil {
+		return nil, err
+	}
+
 	var buf bytes.Buffer
 	gen.write(&buf)
 
@@ -41,6 +55,7 @@ type method struct {
 	name      string
 	in        []*genType
 	out       []*genType
+	withCtx   bool
 	returnErr bool
 }
 
@@ -67,20 +82,75 @@ func (m method) writeMethodDef(structName string, buf *bytes.Buffer) {
 	}
 
 	buf.WriteString(fmt.Sprintf("func (p *%s) %s(%s) (%s) {\n", structName, m.name, strings.Join(inDefs, ", "), strings.Join(outDefs, ", ")))
-	buf.WriteString(`cli, err := p.Select()
-	if err != nil {
-		return
+	call := fmt.Sprintf("cli.%s(%s)", m.name, strings.Join(inNames, ", "))
+
+	// the context passed to the call closure replaces the original one
+	ctxArg, ctxParam, args := "context.TODO()", "context.Context", inNames
+	if m.withCtx {
+		ctxArg, ctxParam, args = inNames[0], inNames[0]+" context.Context", inNames[1:]
+	}
+
+	selectCall := fmt.Sprintf("p.Select(%s)", strings.Join(append([]string{ctxArg, fmt.Sprintf("%q", m.name)}, args...), ", "))
+
+	// the results are kept in the closure until committed, so that the call can be made concurrently
+	outNames := make([]string, 0, len(m.out))
+	retNames := make([]string, 0, len(m.out))
+	for i := range m.out {
+		outNames = append(outNames, fmt.Sprintf("out%d", i))
+		retNames = append(retNames, fmt.Sprintf("ret%d", i))
 	}
-	`)
-	buf.WriteString(fmt.Sprintf("return cli.%s(%s)", m.name, strings.Join(inNames, ", ")))
+
+	commit := "func() {}"
+	if len(outNames) > 0 {
+		commit = fmt.Sprintf("func() { %s = %s }", strings.Join(outNames, ", "), strings.Join(retNames, ", "))
+	}
+
+	body := ""
+	switch {
+	case m.returnErr && len(retNames) == 0:
+		body = fmt.Sprintf("return %s, %s", commit, call)
+
+	case m.returnErr:
+		body = fmt.Sprintf("%s, err := %s\nreturn %s, err", strings.Join(retNames, ", "), call, commit)
+
+	case len(retNames) == 0:
+		body = fmt.Sprintf("%s\nreturn %s, nil", call, commit)
+
+	default:
+		body = fmt.Sprintf("%s := %s\nreturn %s, nil", strings.Join(retNames, ", "), call, commit)
+	}
+
+	wrapped := fmt.Sprintf(`p.Wrap(%s, %q, []interface{}{%s}, func(%s) (func(), error) {
+		cli, err := %s
+		if err != nil {
+			return nil, err
+		}
+
+		%s
+	})`, ctxArg, m.name, strings.Join(args, ", "), ctxParam, selectCall, body)
+
+	if m.returnErr {
+		buf.WriteString(fmt.Sprintf("if p.Wrap != nil {\nerr = %s\nreturn\n}\n\n", wrapped))
+	} else {
+		buf.WriteString(fmt.Sprintf("if p.Wrap != nil {\n_ = %s\nreturn\n}\n\n", wrapped))
+	}
+
+	direct := "return " + call
+	if len(m.out) == 0 && !m.returnErr {
+		direct = call + "\nreturn"
+	}
+
+	buf.WriteString(fmt.Sprintf("cli, err := %s\nif err != nil {\nreturn\n}\n\n%s\n", selectCall, direct))
+
 	buf.WriteString("}\n\n")
 }
 
-func newGenerator(pname string, sname string) *generator {
+func newGenerator(pname string, sname string, perms map[string]string) *generator {
 	return &generator{
 		pkgName:    pname,
 		structName: sname,
 		apis:       make([]api, 0),
+		perms:      perms,
 
 		depCounter: map[string]int{},
 		deps:       map[string]*depDef{},
@@ -92,6 +162,7 @@ type generator struct {
 	pkgName    string
 	structName string
 	apis       []api
+	perms      map[string]string
 
 	depCounter map[string]int
 	deps       map[string]*depDef
@@ -104,12 +175,22 @@ func (g *generator) write(buf *bytes.Buffer) {
 	g.writeTypeAssertion(buf)
 	g.writeInterfaceDef(buf)
 	g.writeStructDef(buf)
+	g.writePerms(buf)
 	g.writeImpls(buf)
 }
 
 func (g *generator) writeImports(buf *bytes.Buffer) {
+	paths := make([]string, 0, len(g.deps))
+	for path := range g.deps {
+		paths = append(paths, path)
+	}
+
+	// map iteration is random, keep the output stable
+	sort.Strings(paths)
+
 	buf.WriteString("import (\n")
-	for path, def := range g.deps {
+	for _, path := range paths {
+		def := g.deps[path]
 		if def.name != "" {
 			buf.WriteString(def.name + " ")
 		}
@@ -135,7 +216,43 @@ func (g *generator) writeTypeAssertion(buf *bytes.Buffer) {
 
 func (g *generator) writeStructDef(buf *bytes.Buffer) {
 	buf.WriteString(fmt.Sprintf("type %s struct {\n", g.structName))
-	buf.WriteString(fmt.Sprintf("Select func() (%sAPI, error)\n", g.structName))
+	buf.WriteString("// Select chooses the upstream for the method, args are the params except the context\n")
+	buf.WriteString(fmt.Sprintf("Select func(ctx context.Context, method string, args ...interface{}) (%sAPI, error)\n\n", g.structName))
+	buf.WriteString("// Wrap is optional, it will be called around each call to the upstream if set.\n")
+	buf.WriteString("// The call selects the upstream and calls it, the results are only set by the returned func,\n")
+	buf.WriteString("// so that the call can be made more than once, even concurrently, and one of the results is taken\n")
+	buf.WriteString("Wrap func(ctx context.Context, method string, args []interface{}, call func(context.Context) (commit func(), err error)) error\n")
+	buf.WriteString("}\n\n")
+}
+
+func (g *generator) checkPerms() error {
+	if g.perms == nil {
+		return nil
+	}
+
+	for _, api := range g.apis {
+		for _, meth := range api.methods {
+			if g.perms[meth.name] == "" {
+				return fmt.Errorf("no permission for %s in %s", meth.name, api.typ)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) writePerms(buf *bytes.Buffer) {
+	if g.perms == nil {
+		return
+	}
+
+	buf.WriteString(fmt.Sprintf("// %sPerms maps the methods to the required permissions\n", g.structName))
+	buf.WriteString(fmt.Sprintf("var %sPerms = map[string]string{\n", g.structName))
+	for _, api := range g.apis {
+		for _, meth := range api.methods {
+			buf.WriteString(fmt.Sprintf("%q: %q,\n", meth.name, g.perms[meth.name]))
+		}
+	}
 	buf.WriteString("}\n\n")
 }
 
@@ -184,6 +301,7 @@ func (g *generator) genMethod(meth reflect.Method) (*method, error) {
 		name:      meth.Name,
 		in:        make([]*genType, 0, numIn),
 		out:       make([]*genType, 0, numOut),
+		withCtx:   numIn > 0 && mtyp.In(0) == ctxType,
 		returnErr: false,
 	}
 
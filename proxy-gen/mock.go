@@ -0,0 +1,159 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"strings"
+)
+
+// GenMock generates a mock implementation for given api interface, named Mock<structName>.
+// Each method can be faked by setting the <Method>Func field, and the calls are recorded.
+// The <structName>API interface generated by Gen should be in the same package.
+func GenMock(pkgName, structName string, api interface{}) ([]byte, error) {
+	gen := newGenerator(pkgName, structName, nil)
+
+	for _, path := range []string{"context", "fmt", "sync"} {
+		if _, err := gen.getDepDef(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := gen.register(reflect.TypeOf(api)); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	gen.writeMock(&buf)
+
+	return format.Source(buf.Bytes())
+}
+
+func (g *generator) writeMock(buf *bytes.Buffer) {
+	mockName := "Mock" + g.structName
+
+	buf.WriteString(fmt.Sprintf("package %s\n\n", g.pkgName))
+	g.writeImports(buf)
+	buf.WriteString(fmt.Sprintf("var _ %sAPI = (*%s)(nil)\n", g.structName, mockName))
+	for _, api := range g.apis {
+		buf.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n", g.types[api.typ], mockName))
+	}
+	buf.WriteString("\n")
+
+	buf.WriteString(fmt.Sprintf("// %sCall is a call recorded by %s, Args are the params except the context\n", mockName, mockName))
+	buf.WriteString(fmt.Sprintf("type %sCall struct {\nMethod string\nArgs []interface{}\n}\n\n", mockName))
+
+	buf.WriteString(fmt.Sprintf("// %s is a mock implementation of %sAPI, methods without a fake func return zero values and an error\n", mockName, g.structName))
+	buf.WriteString(fmt.Sprintf("type %s struct {\n", mockName))
+	for _, api := range g.apis {
+		for _, meth := range api.methods {
+			buf.WriteString(fmt.Sprintf("%sFunc %s\n", meth.name, meth.funcType()))
+		}
+	}
+	buf.WriteString(fmt.Sprintf("\ncalls struct {\nsync.Mutex\nrecords []%sCall\n}\n", mockName))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(fmt.Sprintf(`func (m *%s) record(method string, args ...interface{}) {
+	m.calls.Lock()
+	m.calls.records = append(m.calls.records, %sCall{Method: method, Args: args})
+	m.calls.Unlock()
+}
+
+// Calls returns the recorded calls
+func (m *%s) Calls() []%sCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	calls := make([]%sCall, len(m.calls.records))
+	copy(calls, m.calls.records)
+	return calls
+}
+
+// CallsOf returns the recorded calls of the given method
+func (m *%s) CallsOf(method string) []%sCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	var calls []%sCall
+	for _, call := range m.calls.records {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+`, mockName, mockName, mockName, mockName, mockName, mockName, mockName, mockName))
+
+	for _, api := range g.apis {
+		buf.WriteString(fmt.Sprintf("// impl %s\n", api.typ))
+		for _, meth := range api.methods {
+			meth.writeMockDef(mockName, buf)
+		}
+		buf.WriteString("\n\n")
+	}
+}
+
+// funcType returns the func type with the same signature as the method
+func (m method) funcType() string {
+	ins := make([]string, 0, len(m.in))
+	for i := range m.in {
+		ins = append(ins, m.in[i].String())
+	}
+
+	outs := make([]string, 0, len(m.out)+1)
+	for i := range m.out {
+		outs = append(outs, m.out[i].String())
+	}
+
+	if m.returnErr {
+		outs = append(outs, "error")
+	}
+
+	return fmt.Sprintf("func(%s) (%s)", strings.Join(ins, ", "), strings.Join(outs, ", "))
+}
+
+func (m method) writeMockDef(mockName string, buf *bytes.Buffer) {
+	inDefs := make([]string, 0, len(m.in))
+	inNames := make([]string, 0, len(m.in))
+	outDefs := make([]string, 0, len(m.out))
+
+	for i := range m.in {
+		inName := fmt.Sprintf("in%d", i)
+		inNames = append(inNames, inName)
+		inDefs = append(inDefs, fmt.Sprintf("%s %s", inName, m.in[i]))
+	}
+
+	for i := range m.out {
+		outDefs = append(outDefs, fmt.Sprintf("out%d %s", i, m.out[i]))
+	}
+
+	if m.returnErr {
+		outDefs = append(outDefs, "err error")
+	}
+
+	args := inNames
+	if m.withCtx {
+		args = inNames[1:]
+	}
+
+	call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(inNames, ", "))
+
+	buf.WriteString(fmt.Sprintf("func (m *%s) %s(%s) (%s) {\n", mockName, m.name, strings.Join(inDefs, ", "), strings.Join(outDefs, ", ")))
+	buf.WriteString(fmt.Sprintf("m.record(%s)\n", strings.Join(append([]string{fmt.Sprintf("%q", m.name)}, args...), ", ")))
+	buf.WriteString(fmt.Sprintf("if m.%sFunc == nil {\n", m.name))
+	if m.returnErr {
+		buf.WriteString(fmt.Sprintf("err = fmt.Errorf(\"%s.%s not mocked\")\n", mockName, m.name))
+	}
+	buf.WriteString("return\n}\n\n")
+
+	if len(m.out) == 0 && !m.returnErr {
+		buf.WriteString(fmt.Sprintf("%s\nreturn", call))
+	} else {
+		buf.WriteString(fmt.Sprintf("return %s", call))
+	}
+
+	buf.WriteString("}\n\n")
+}
@@ -0,0 +1,119 @@
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const permPrefix = "//perm:"
+
+// ParsePerms collects the permissions from the `//perm:<perm>` annotations in the doc comments
+// of the interface methods in the given source file
+func ParsePerms(path string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := map[string]string{}
+	for _, decl := range file.Decls {
+		gdecl, ok := decl.(*ast.GenDecl)
+		if !ok || gdecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gdecl.Specs {
+			iface, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+
+			for _, field := range iface.Methods.List {
+				// embedded interfaces
+				if len(field.Names) == 0 || field.Doc == nil {
+					continue
+				}
+
+				for _, c := range field.Doc.List {
+					if !strings.HasPrefix(c.Text, permPrefix) {
+						continue
+					}
+
+					name := field.Names[0].Name
+					perm := strings.TrimSpace(strings.TrimPrefix(c.Text, permPrefix))
+					if prev, ok := perms[name]; ok && prev != perm {
+						return nil, fmt.Errorf("conflicting permissions %s and %s for %s", prev, perm, name)
+					}
+
+					perms[name] = perm
+				}
+			}
+		}
+	}
+
+	return perms, nil
+}
+
+// StructPerms collects the permissions from the `perm` tags of the Internal fields in the given
+// api structs, like the ones in lotus apistruct, the embedded structs are walked into
+func StructPerms(structs ...interface{}) map[string]string {
+	perms := map[string]string{}
+	for _, s := range structs {
+		collectStructPerms(reflect.TypeOf(s), perms)
+	}
+
+	return perms
+}
+
+func collectStructPerms(typ reflect.Type, perms map[string]string) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			collectStructPerms(field.Type, perms)
+			continue
+		}
+
+		if field.Name != "Internal" || field.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < field.Type.NumField(); j++ {
+			meth := field.Type.Field(j)
+			if perm, ok := meth.Tag.Lookup("perm"); ok {
+				perms[meth.Name] = perm
+			}
+		}
+	}
+}
+
+// ComparePerms returns an error listing the methods whose permissions differ from the expected ones,
+// methods missing in either of the maps are ignored
+func ComparePerms(perms map[string]string, expected map[string]string) error {
+	var diffs []string
+	for name, perm := range perms {
+		if want, ok := expected[name]; ok && want != perm {
+			diffs = append(diffs, fmt.Sprintf("%s: %s, expected %s", name, perm, want))
+		}
+	}
+
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	sort.Strings(diffs)
+	return fmt.Errorf("permissions mismatch:\n\t%s", strings.Join(diffs, "\n\t"))
+}
@@ -3,7 +3,12 @@ package proxy
 import (
 	"context"
 	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
 	api1 "github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
 )
 
 var _ LocalAPI = (*Local)(nil)
@@ -13,14 +18,565 @@ type LocalAPI interface {
 }
 
 type Local struct {
-	Select func() (LocalAPI, error)
+	// Select chooses the upstream for the method, args are the params except the context
+	Select func(ctx context.Context, method string, args ...interface{}) (LocalAPI, error)
+
+	// Wrap is optional, it will be called around each call to the upstream if set.
+	// The call selects the upstream and calls it, the results are only set by the returned func,
+	// so that the call can be made more than once, even concurrently, and one of the results is taken
+	Wrap func(ctx context.Context, method string, args []interface{}, call func(context.Context) (commit func(), err error)) error
+}
+
+// LocalPerms maps the methods to the required permissions
+var LocalPerms = map[string]string{
+	"ChainNotify":           "read",
+	"GasEstimateFeeCap":     "read",
+	"GasEstimateGasLimit":   "read",
+	"GasEstimateGasPremium": "read",
+	"GasEstimateMessageGas": "read",
+	"MinerCreateBlock":      "write",
+	"MinerGetBaseInfo":      "read",
+	"MpoolBatchPushMessage": "sign",
+	"MpoolGetNonce":         "read",
+	"MpoolPending":          "read",
+	"MpoolPushMessage":      "sign",
+	"MpoolSub":              "read",
+	"SyncState":             "read",
+	"SyncSubmitBlock":       "write",
+	"WalletDefaultAddress":  "write",
+	"WalletDelete":          "write",
+	"WalletExport":          "admin",
+	"WalletHas":             "write",
+	"WalletImport":          "admin",
+	"WalletList":            "write",
+	"WalletNew":             "write",
+	"WalletSetDefault":      "admin",
+	"WalletSign":            "sign",
+	"WalletSignMessage":     "sign",
 }
 
 // impl api.Local
 func (p *Local) ChainNotify(in0 context.Context) (out0 <-chan []*api1.HeadChange, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainNotify", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainNotify")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainNotify(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainNotify")
 	if err != nil {
 		return
 	}
+
 	return cli.ChainNotify(in0)
 }
+
+func (p *Local) GasEstimateFeeCap(in0 context.Context, in1 *types.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "GasEstimateFeeCap", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "GasEstimateFeeCap", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.GasEstimateFeeCap(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "GasEstimateFeeCap", in1, in2, in3)
+	if err != nil {
+		return
+	}
+
+	return cli.GasEstimateFeeCap(in0, in1, in2, in3)
+}
+
+func (p *Local) GasEstimateGasLimit(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 int64, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "GasEstimateGasLimit", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "GasEstimateGasLimit", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.GasEstimateGasLimit(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "GasEstimateGasLimit", in1, in2)
+	if err != nil {
+		return
+	}
+
+	return cli.GasEstimateGasLimit(in0, in1, in2)
+}
+
+func (p *Local) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "GasEstimateGasPremium", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "GasEstimateGasPremium", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "GasEstimateGasPremium", in1, in2, in3, in4)
+	if err != nil {
+		return
+	}
+
+	return cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) GasEstimateMessageGas(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec, in3 types.TipSetKey) (out0 *types.Message, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "GasEstimateMessageGas", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "GasEstimateMessageGas", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.GasEstimateMessageGas(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "GasEstimateMessageGas", in1, in2, in3)
+	if err != nil {
+		return
+	}
+
+	return cli.GasEstimateMessageGas(in0, in1, in2, in3)
+}
+
+func (p *Local) MinerCreateBlock(in0 context.Context, in1 *api1.BlockTemplate) (out0 *types.BlockMsg, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MinerCreateBlock", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MinerCreateBlock", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MinerCreateBlock(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MinerCreateBlock", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MinerCreateBlock(in0, in1)
+}
+
+func (p *Local) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 abi.ChainEpoch, in3 types.TipSetKey) (out0 *api1.MiningBaseInfo, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MinerGetBaseInfo", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MinerGetBaseInfo", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MinerGetBaseInfo(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MinerGetBaseInfo", in1, in2, in3)
+	if err != nil {
+		return
+	}
+
+	return cli.MinerGetBaseInfo(in0, in1, in2, in3)
+}
+
+func (p *Local) MpoolBatchPushMessage(in0 context.Context, in1 []*types.Message, in2 *api1.MessageSendSpec) (out0 []*types.SignedMessage, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolBatchPushMessage", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolBatchPushMessage", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolBatchPushMessage(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolBatchPushMessage", in1, in2)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolBatchPushMessage(in0, in1, in2)
+}
+
+func (p *Local) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolGetNonce", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolGetNonce", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolGetNonce(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolGetNonce", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolGetNonce(in0, in1)
+}
+
+func (p *Local) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*types.SignedMessage, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolPending", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolPending", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolPending(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolPending", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolPending(in0, in1)
+}
+
+func (p *Local) MpoolPushMessage(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec) (out0 *types.SignedMessage, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolPushMessage", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolPushMessage", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolPushMessage(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolPushMessage", in1, in2)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolPushMessage(in0, in1, in2)
+}
+
+func (p *Local) MpoolSub(in0 context.Context) (out0 <-chan api1.MpoolUpdate, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolSub", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolSub")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolSub(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolSub")
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolSub(in0)
+}
+
+func (p *Local) SyncState(in0 context.Context) (out0 *api1.SyncState, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncState", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncState")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.SyncState(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "SyncState")
+	if err != nil {
+		return
+	}
+
+	return cli.SyncState(in0)
+}
+
+func (p *Local) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncSubmitBlock", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncSubmitBlock", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.SyncSubmitBlock(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "SyncSubmitBlock", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.SyncSubmitBlock(in0, in1)
+}
+
+func (p *Local) WalletDefaultAddress(in0 context.Context) (out0 address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletDefaultAddress", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletDefaultAddress")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletDefaultAddress(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletDefaultAddress")
+	if err != nil {
+		return
+	}
+
+	return cli.WalletDefaultAddress(in0)
+}
+
+func (p *Local) WalletDelete(in0 context.Context, in1 address.Address) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletDelete", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletDelete", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.WalletDelete(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletDelete", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletDelete(in0, in1)
+}
+
+func (p *Local) WalletExport(in0 context.Context, in1 address.Address) (out0 *types.KeyInfo, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletExport", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletExport", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletExport(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletExport", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletExport(in0, in1)
+}
+
+func (p *Local) WalletHas(in0 context.Context, in1 address.Address) (out0 bool, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletHas", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletHas", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletHas(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletHas", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletHas(in0, in1)
+}
+
+func (p *Local) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0 address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletImport", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletImport", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletImport(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletImport", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletImport(in0, in1)
+}
+
+func (p *Local) WalletList(in0 context.Context) (out0 []address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletList", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletList")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletList(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletList")
+	if err != nil {
+		return
+	}
+
+	return cli.WalletList(in0)
+}
+
+func (p *Local) WalletNew(in0 context.Context, in1 types.KeyType) (out0 address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletNew", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletNew", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletNew(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletNew", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletNew(in0, in1)
+}
+
+func (p *Local) WalletSetDefault(in0 context.Context, in1 address.Address) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletSetDefault", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletSetDefault", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.WalletSetDefault(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletSetDefault", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletSetDefault(in0, in1)
+}
+
+func (p *Local) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8) (out0 *crypto.Signature, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletSign", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletSign", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletSign(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletSign", in1, in2)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletSign(in0, in1, in2)
+}
+
+func (p *Local) WalletSignMessage(in0 context.Context, in1 address.Address, in2 *types.Message) (out0 *types.SignedMessage, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletSignMessage", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletSignMessage", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletSignMessage(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletSignMessage", in1, in2)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletSignMessage(in0, in1, in2)
+}
@@ -0,0 +1,328 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	api1 "github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"sync"
+)
+
+var _ LocalAPI = (*MockLocal)(nil)
+var _ api.Local = (*MockLocal)(nil)
+
+// MockLocalCall is a call recorded by MockLocal, Args are the params except the context
+type MockLocalCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// MockLocal is a mock implementation of LocalAPI, methods without a fake func return zero values and an error
+type MockLocal struct {
+	ChainNotifyFunc           func(context.Context) (<-chan []*api1.HeadChange, error)
+	GasEstimateFeeCapFunc     func(context.Context, *types.Message, int64, types.TipSetKey) (big.Int, error)
+	GasEstimateGasLimitFunc   func(context.Context, *types.Message, types.TipSetKey) (int64, error)
+	GasEstimateGasPremiumFunc func(context.Context, uint64, address.Address, int64, types.TipSetKey) (big.Int, error)
+	GasEstimateMessageGasFunc func(context.Context, *types.Message, *api1.MessageSendSpec, types.TipSetKey) (*types.Message, error)
+	MinerCreateBlockFunc      func(context.Context, *api1.BlockTemplate) (*types.BlockMsg, error)
+	MinerGetBaseInfoFunc      func(context.Context, address.Address, abi.ChainEpoch, types.TipSetKey) (*api1.MiningBaseInfo, error)
+	MpoolBatchPushMessageFunc func(context.Context, []*types.Message, *api1.MessageSendSpec) ([]*types.SignedMessage, error)
+	MpoolGetNonceFunc         func(context.Context, address.Address) (uint64, error)
+	MpoolPendingFunc          func(context.Context, types.TipSetKey) ([]*types.SignedMessage, error)
+	MpoolPushMessageFunc      func(context.Context, *types.Message, *api1.MessageSendSpec) (*types.SignedMessage, error)
+	MpoolSubFunc              func(context.Context) (<-chan api1.MpoolUpdate, error)
+	SyncStateFunc             func(context.Context) (*api1.SyncState, error)
+	SyncSubmitBlockFunc       func(context.Context, *types.BlockMsg) error
+	WalletDefaultAddressFunc  func(context.Context) (address.Address, error)
+	WalletDeleteFunc          func(context.Context, address.Address) error
+	WalletExportFunc          func(context.Context, address.Address) (*types.KeyInfo, error)
+	WalletHasFunc             func(context.Context, address.Address) (bool, error)
+	WalletImportFunc          func(context.Context, *types.KeyInfo) (address.Address, error)
+	WalletListFunc            func(context.Context) ([]address.Address, error)
+	WalletNewFunc             func(context.Context, types.KeyType) (address.Address, error)
+	WalletSetDefaultFunc      func(context.Context, address.Address) error
+	WalletSignFunc            func(context.Context, address.Address, []uint8) (*crypto.Signature, error)
+	WalletSignMessageFunc     func(context.Context, address.Address, *types.Message) (*types.SignedMessage, error)
+
+	calls struct {
+		sync.Mutex
+		records []MockLocalCall
+	}
+}
+
+func (m *MockLocal) record(method string, args ...interface{}) {
+	m.calls.Lock()
+	m.calls.records = append(m.calls.records, MockLocalCall{Method: method, Args: args})
+	m.calls.Unlock()
+}
+
+// Calls returns the recorded calls
+func (m *MockLocal) Calls() []MockLocalCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	calls := make([]MockLocalCall, len(m.calls.records))
+	copy(calls, m.calls.records)
+	return calls
+}
+
+// CallsOf returns the recorded calls of the given method
+func (m *MockLocal) CallsOf(method string) []MockLocalCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	var calls []MockLocalCall
+	for _, call := range m.calls.records {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// impl api.Local
+func (m *MockLocal) ChainNotify(in0 context.Context) (out0 <-chan []*api1.HeadChange, err error) {
+	m.record("ChainNotify")
+	if m.ChainNotifyFunc == nil {
+		err = fmt.Errorf("MockLocal.ChainNotify not mocked")
+		return
+	}
+
+	return m.ChainNotifyFunc(in0)
+}
+
+func (m *MockLocal) GasEstimateFeeCap(in0 context.Context, in1 *types.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("GasEstimateFeeCap", in1, in2, in3)
+	if m.GasEstimateFeeCapFunc == nil {
+		err = fmt.Errorf("MockLocal.GasEstimateFeeCap not mocked")
+		return
+	}
+
+	return m.GasEstimateFeeCapFunc(in0, in1, in2, in3)
+}
+
+func (m *MockLocal) GasEstimateGasLimit(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 int64, err error) {
+	m.record("GasEstimateGasLimit", in1, in2)
+	if m.GasEstimateGasLimitFunc == nil {
+		err = fmt.Errorf("MockLocal.GasEstimateGasLimit not mocked")
+		return
+	}
+
+	return m.GasEstimateGasLimitFunc(in0, in1, in2)
+}
+
+func (m *MockLocal) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("GasEstimateGasPremium", in1, in2, in3, in4)
+	if m.GasEstimateGasPremiumFunc == nil {
+		err = fmt.Errorf("MockLocal.GasEstimateGasPremium not mocked")
+		return
+	}
+
+	return m.GasEstimateGasPremiumFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockLocal) GasEstimateMessageGas(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec, in3 types.TipSetKey) (out0 *types.Message, err error) {
+	m.record("GasEstimateMessageGas", in1, in2, in3)
+	if m.GasEstimateMessageGasFunc == nil {
+		err = fmt.Errorf("MockLocal.GasEstimateMessageGas not mocked")
+		return
+	}
+
+	return m.GasEstimateMessageGasFunc(in0, in1, in2, in3)
+}
+
+func (m *MockLocal) MinerCreateBlock(in0 context.Context, in1 *api1.BlockTemplate) (out0 *types.BlockMsg, err error) {
+	m.record("MinerCreateBlock", in1)
+	if m.MinerCreateBlockFunc == nil {
+		err = fmt.Errorf("MockLocal.MinerCreateBlock not mocked")
+		return
+	}
+
+	return m.MinerCreateBlockFunc(in0, in1)
+}
+
+func (m *MockLocal) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 abi.ChainEpoch, in3 types.TipSetKey) (out0 *api1.MiningBaseInfo, err error) {
+	m.record("MinerGetBaseInfo", in1, in2, in3)
+	if m.MinerGetBaseInfoFunc == nil {
+		err = fmt.Errorf("MockLocal.MinerGetBaseInfo not mocked")
+		return
+	}
+
+	return m.MinerGetBaseInfoFunc(in0, in1, in2, in3)
+}
+
+func (m *MockLocal) MpoolBatchPushMessage(in0 context.Context, in1 []*types.Message, in2 *api1.MessageSendSpec) (out0 []*types.SignedMessage, err error) {
+	m.record("MpoolBatchPushMessage", in1, in2)
+	if m.MpoolBatchPushMessageFunc == nil {
+		err = fmt.Errorf("MockLocal.MpoolBatchPushMessage not mocked")
+		return
+	}
+
+	return m.MpoolBatchPushMessageFunc(in0, in1, in2)
+}
+
+func (m *MockLocal) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
+	m.record("MpoolGetNonce", in1)
+	if m.MpoolGetNonceFunc == nil {
+		err = fmt.Errorf("MockLocal.MpoolGetNonce not mocked")
+		return
+	}
+
+	return m.MpoolGetNonceFunc(in0, in1)
+}
+
+func (m *MockLocal) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*types.SignedMessage, err error) {
+	m.record("MpoolPending", in1)
+	if m.MpoolPendingFunc == nil {
+		err = fmt.Errorf("MockLocal.MpoolPending not mocked")
+		return
+	}
+
+	return m.MpoolPendingFunc(in0, in1)
+}
+
+func (m *MockLocal) MpoolPushMessage(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec) (out0 *types.SignedMessage, err error) {
+	m.record("MpoolPushMessage", in1, in2)
+	if m.MpoolPushMessageFunc == nil {
+		err = fmt.Errorf("MockLocal.MpoolPushMessage not mocked")
+		return
+	}
+
+	return m.MpoolPushMessageFunc(in0, in1, in2)
+}
+
+func (m *MockLocal) MpoolSub(in0 context.Context) (out0 <-chan api1.MpoolUpdate, err error) {
+	m.record("MpoolSub")
+	if m.MpoolSubFunc == nil {
+		err = fmt.Errorf("MockLocal.MpoolSub not mocked")
+		return
+	}
+
+	return m.MpoolSubFunc(in0)
+}
+
+func (m *MockLocal) SyncState(in0 context.Context) (out0 *api1.SyncState, err error) {
+	m.record("SyncState")
+	if m.SyncStateFunc == nil {
+		err = fmt.Errorf("MockLocal.SyncState not mocked")
+		return
+	}
+
+	return m.SyncStateFunc(in0)
+}
+
+func (m *MockLocal) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err error) {
+	m.record("SyncSubmitBlock", in1)
+	if m.SyncSubmitBlockFunc == nil {
+		err = fmt.Errorf("MockLocal.SyncSubmitBlock not mocked")
+		return
+	}
+
+	return m.SyncSubmitBlockFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletDefaultAddress(in0 context.Context) (out0 address.Address, err error) {
+	m.record("WalletDefaultAddress")
+	if m.WalletDefaultAddressFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletDefaultAddress not mocked")
+		return
+	}
+
+	return m.WalletDefaultAddressFunc(in0)
+}
+
+func (m *MockLocal) WalletDelete(in0 context.Context, in1 address.Address) (err error) {
+	m.record("WalletDelete", in1)
+	if m.WalletDeleteFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletDelete not mocked")
+		return
+	}
+
+	return m.WalletDeleteFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletExport(in0 context.Context, in1 address.Address) (out0 *types.KeyInfo, err error) {
+	m.record("WalletExport", in1)
+	if m.WalletExportFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletExport not mocked")
+		return
+	}
+
+	return m.WalletExportFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletHas(in0 context.Context, in1 address.Address) (out0 bool, err error) {
+	m.record("WalletHas", in1)
+	if m.WalletHasFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletHas not mocked")
+		return
+	}
+
+	return m.WalletHasFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0 address.Address, err error) {
+	m.record("WalletImport", in1)
+	if m.WalletImportFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletImport not mocked")
+		return
+	}
+
+	return m.WalletImportFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletList(in0 context.Context) (out0 []address.Address, err error) {
+	m.record("WalletList")
+	if m.WalletListFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletList not mocked")
+		return
+	}
+
+	return m.WalletListFunc(in0)
+}
+
+func (m *MockLocal) WalletNew(in0 context.Context, in1 types.KeyType) (out0 address.Address, err error) {
+	m.record("WalletNew", in1)
+	if m.WalletNewFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletNew not mocked")
+		return
+	}
+
+	return m.WalletNewFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletSetDefault(in0 context.Context, in1 address.Address) (err error) {
+	m.record("WalletSetDefault", in1)
+	if m.WalletSetDefaultFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletSetDefault not mocked")
+		return
+	}
+
+	return m.WalletSetDefaultFunc(in0, in1)
+}
+
+func (m *MockLocal) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8) (out0 *crypto.Signature, err error) {
+	m.record("WalletSign", in1, in2)
+	if m.WalletSignFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletSign not mocked")
+		return
+	}
+
+	return m.WalletSignFunc(in0, in1, in2)
+}
+
+func (m *MockLocal) WalletSignMessage(in0 context.Context, in1 address.Address, in2 *types.Message) (out0 *types.SignedMessage, err error) {
+	m.record("WalletSignMessage", in1, in2)
+	if m.WalletSignMessageFunc == nil {
+		err = fmt.Errorf("MockLocal.WalletSignMessage not mocked")
+		return
+	}
+
+	return m.WalletSignMessageFunc(in0, in1, in2)
+}
@@ -0,0 +1,25 @@
+package proxy
+
+import (
+	"github.com/filecoin-project/lotus/api"
+)
+
+var _ api.FullNode = (*MockFullNode)(nil)
+
+// MockFullNode combines the generated mocks into an api.FullNode, which can be used as a fake upstream
+type MockFullNode struct {
+	*MockProxy
+	*MockWriteProxy
+	*MockLocal
+	*MockUnSupport
+}
+
+// NewMockFullNode constructs a MockFullNode instance, the calls are recorded by the mock of each group
+func NewMockFullNode() *MockFullNode {
+	return &MockFullNode{
+		MockProxy:      &MockProxy{},
+		MockWriteProxy: &MockWriteProxy{},
+		MockLocal:      &MockLocal{},
+		MockUnSupport:  &MockUnSupport{},
+	}
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/go-state-types/crypto"
@@ -18,110 +19,384 @@ type ProxyAPI interface {
 }
 
 type Proxy struct {
-	Select func() (ProxyAPI, error)
+	// Select chooses the upstream for the method, args are the params except the context
+	Select func(ctx context.Context, method string, args ...interface{}) (ProxyAPI, error)
+
+	// Wrap is optional, it will be called around each call to the upstream if set.
+	// The call selects the upstream and calls it, the results are only set by the returned func,
+	// so that the call can be made more than once, even concurrently, and one of the results is taken
+	Wrap func(ctx context.Context, method string, args []interface{}, call func(context.Context) (commit func(), err error)) error
+}
+
+// ProxyPerms maps the methods to the required permissions
+var ProxyPerms = map[string]string{
+	"BeaconGetEntry":                "read",
+	"ChainGetBlock":                 "read",
+	"ChainGetBlockMessages":         "read",
+	"ChainGetGenesis":               "read",
+	"ChainGetMessage":               "read",
+	"ChainGetParentMessages":        "read",
+	"ChainGetParentReceipts":        "read",
+	"ChainGetRandomnessFromBeacon":  "read",
+	"ChainGetRandomnessFromTickets": "read",
+	"ChainGetTipSet":                "read",
+	"ChainGetTipSetByHeight":        "read",
+	"ChainHead":                     "read",
+	"ChainTipSetWeight":             "read",
+	"WalletBalance":                 "read",
+	"WalletValidateAddress":         "read",
+	"WalletVerify":                  "read",
 }
 
 // impl api.Proxy
 func (p *Proxy) BeaconGetEntry(in0 context.Context, in1 abi.ChainEpoch) (out0 *types.BeaconEntry, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "BeaconGetEntry", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "BeaconGetEntry", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.BeaconGetEntry(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "BeaconGetEntry", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.BeaconGetEntry(in0, in1)
 }
 
 func (p *Proxy) ChainGetBlock(in0 context.Context, in1 cid.Cid) (out0 *types.BlockHeader, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetBlock", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetBlock", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetBlock(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetBlock", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetBlock(in0, in1)
 }
 
 func (p *Proxy) ChainGetBlockMessages(in0 context.Context, in1 cid.Cid) (out0 *api1.BlockMessages, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetBlockMessages", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetBlockMessages", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetBlockMessages(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetBlockMessages", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetBlockMessages(in0, in1)
 }
 
 func (p *Proxy) ChainGetGenesis(in0 context.Context) (out0 *types.TipSet, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetGenesis", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetGenesis")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetGenesis(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetGenesis")
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetGenesis(in0)
 }
 
 func (p *Proxy) ChainGetMessage(in0 context.Context, in1 cid.Cid) (out0 *types.Message, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetMessage", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetMessage", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetMessage(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetMessage", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetMessage(in0, in1)
 }
 
 func (p *Proxy) ChainGetParentMessages(in0 context.Context, in1 cid.Cid) (out0 []api1.Message, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetParentMessages", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetParentMessages", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetParentMessages(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetParentMessages", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetParentMessages(in0, in1)
 }
 
 func (p *Proxy) ChainGetParentReceipts(in0 context.Context, in1 cid.Cid) (out0 []*types.MessageReceipt, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetParentReceipts", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetParentReceipts", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetParentReceipts(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetParentReceipts", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetParentReceipts(in0, in1)
 }
 
 func (p *Proxy) ChainGetRandomnessFromBeacon(in0 context.Context, in1 types.TipSetKey, in2 crypto.DomainSeparationTag, in3 abi.ChainEpoch, in4 []uint8) (out0 abi.Randomness, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetRandomnessFromBeacon", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetRandomnessFromBeacon", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetRandomnessFromBeacon(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetRandomnessFromBeacon", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetRandomnessFromBeacon(in0, in1, in2, in3, in4)
 }
 
 func (p *Proxy) ChainGetRandomnessFromTickets(in0 context.Context, in1 types.TipSetKey, in2 crypto.DomainSeparationTag, in3 abi.ChainEpoch, in4 []uint8) (out0 abi.Randomness, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetRandomnessFromTickets", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetRandomnessFromTickets", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetRandomnessFromTickets(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetRandomnessFromTickets", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetRandomnessFromTickets(in0, in1, in2, in3, in4)
 }
 
 func (p *Proxy) ChainGetTipSet(in0 context.Context, in1 types.TipSetKey) (out0 *types.TipSet, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetTipSet", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetTipSet", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetTipSet(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetTipSet", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetTipSet(in0, in1)
 }
 
 func (p *Proxy) ChainGetTipSetByHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetTipSetByHeight", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetTipSetByHeight", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetTipSetByHeight(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetTipSetByHeight", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetTipSetByHeight(in0, in1, in2)
 }
 
 func (p *Proxy) ChainHead(in0 context.Context) (out0 *types.TipSet, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainHead", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainHead")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainHead(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainHead")
 	if err != nil {
 		return
 	}
+
 	return cli.ChainHead(in0)
 }
 
 func (p *Proxy) ChainTipSetWeight(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainTipSetWeight", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainTipSetWeight", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainTipSetWeight(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainTipSetWeight", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainTipSetWeight(in0, in1)
 }
+
+func (p *Proxy) WalletBalance(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletBalance", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletBalance", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletBalance(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletBalance", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletBalance(in0, in1)
+}
+
+func (p *Proxy) WalletValidateAddress(in0 context.Context, in1 string) (out0 address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletValidateAddress", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletValidateAddress", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletValidateAddress(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletValidateAddress", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletValidateAddress(in0, in1)
+}
+
+func (p *Proxy) WalletVerify(in0 context.Context, in1 address.Address, in2 []uint8, in3 *crypto.Signature) (out0 bool, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "WalletVerify", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "WalletVerify", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.WalletVerify(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "WalletVerify", in1, in2, in3)
+	if err != nil {
+		return
+	}
+
+	return cli.WalletVerify(in0, in1, in2, in3)
+}
@@ -0,0 +1,241 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	api1 "github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"sync"
+)
+
+var _ ProxyAPI = (*MockProxy)(nil)
+var _ api.Proxy = (*MockProxy)(nil)
+
+// MockProxyCall is a call recorded by MockProxy, Args are the params except the context
+type MockProxyCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// MockProxy is a mock implementation of ProxyAPI, methods without a fake func return zero values and an error
+type MockProxy struct {
+	BeaconGetEntryFunc                func(context.Context, abi.ChainEpoch) (*types.BeaconEntry, error)
+	ChainGetBlockFunc                 func(context.Context, cid.Cid) (*types.BlockHeader, error)
+	ChainGetBlockMessagesFunc         func(context.Context, cid.Cid) (*api1.BlockMessages, error)
+	ChainGetGenesisFunc               func(context.Context) (*types.TipSet, error)
+	ChainGetMessageFunc               func(context.Context, cid.Cid) (*types.Message, error)
+	ChainGetParentMessagesFunc        func(context.Context, cid.Cid) ([]api1.Message, error)
+	ChainGetParentReceiptsFunc        func(context.Context, cid.Cid) ([]*types.MessageReceipt, error)
+	ChainGetRandomnessFromBeaconFunc  func(context.Context, types.TipSetKey, crypto.DomainSeparationTag, abi.ChainEpoch, []uint8) (abi.Randomness, error)
+	ChainGetRandomnessFromTicketsFunc func(context.Context, types.TipSetKey, crypto.DomainSeparationTag, abi.ChainEpoch, []uint8) (abi.Randomness, error)
+	ChainGetTipSetFunc                func(context.Context, types.TipSetKey) (*types.TipSet, error)
+	ChainGetTipSetByHeightFunc        func(context.Context, abi.ChainEpoch, types.TipSetKey) (*types.TipSet, error)
+	ChainHeadFunc                     func(context.Context) (*types.TipSet, error)
+	ChainTipSetWeightFunc             func(context.Context, types.TipSetKey) (big.Int, error)
+	WalletBalanceFunc                 func(context.Context, address.Address) (big.Int, error)
+	WalletValidateAddressFunc         func(context.Context, string) (address.Address, error)
+	WalletVerifyFunc                  func(context.Context, address.Address, []uint8, *crypto.Signature) (bool, error)
+
+	calls struct {
+		sync.Mutex
+		records []MockProxyCall
+	}
+}
+
+func (m *MockProxy) record(method string, args ...interface{}) {
+	m.calls.Lock()
+	m.calls.records = append(m.calls.records, MockProxyCall{Method: method, Args: args})
+	m.calls.Unlock()
+}
+
+// Calls returns the recorded calls
+func (m *MockProxy) Calls() []MockProxyCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	calls := make([]MockProxyCall, len(m.calls.records))
+	copy(calls, m.calls.records)
+	return calls
+}
+
+// CallsOf returns the recorded calls of the given method
+func (m *MockProxy) CallsOf(method string) []MockProxyCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	var calls []MockProxyCall
+	for _, call := range m.calls.records {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// impl api.Proxy
+func (m *MockProxy) BeaconGetEntry(in0 context.Context, in1 abi.ChainEpoch) (out0 *types.BeaconEntry, err error) {
+	m.record("BeaconGetEntry", in1)
+	if m.BeaconGetEntryFunc == nil {
+		err = fmt.Errorf("MockProxy.BeaconGetEntry not mocked")
+		return
+	}
+
+	return m.BeaconGetEntryFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetBlock(in0 context.Context, in1 cid.Cid) (out0 *types.BlockHeader, err error) {
+	m.record("ChainGetBlock", in1)
+	if m.ChainGetBlockFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetBlock not mocked")
+		return
+	}
+
+	return m.ChainGetBlockFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetBlockMessages(in0 context.Context, in1 cid.Cid) (out0 *api1.BlockMessages, err error) {
+	m.record("ChainGetBlockMessages", in1)
+	if m.ChainGetBlockMessagesFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetBlockMessages not mocked")
+		return
+	}
+
+	return m.ChainGetBlockMessagesFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetGenesis(in0 context.Context) (out0 *types.TipSet, err error) {
+	m.record("ChainGetGenesis")
+	if m.ChainGetGenesisFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetGenesis not mocked")
+		return
+	}
+
+	return m.ChainGetGenesisFunc(in0)
+}
+
+func (m *MockProxy) ChainGetMessage(in0 context.Context, in1 cid.Cid) (out0 *types.Message, err error) {
+	m.record("ChainGetMessage", in1)
+	if m.ChainGetMessageFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetMessage not mocked")
+		return
+	}
+
+	return m.ChainGetMessageFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetParentMessages(in0 context.Context, in1 cid.Cid) (out0 []api1.Message, err error) {
+	m.record("ChainGetParentMessages", in1)
+	if m.ChainGetParentMessagesFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetParentMessages not mocked")
+		return
+	}
+
+	return m.ChainGetParentMessagesFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetParentReceipts(in0 context.Context, in1 cid.Cid) (out0 []*types.MessageReceipt, err error) {
+	m.record("ChainGetParentReceipts", in1)
+	if m.ChainGetParentReceiptsFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetParentReceipts not mocked")
+		return
+	}
+
+	return m.ChainGetParentReceiptsFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetRandomnessFromBeacon(in0 context.Context, in1 types.TipSetKey, in2 crypto.DomainSeparationTag, in3 abi.ChainEpoch, in4 []uint8) (out0 abi.Randomness, err error) {
+	m.record("ChainGetRandomnessFromBeacon", in1, in2, in3, in4)
+	if m.ChainGetRandomnessFromBeaconFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetRandomnessFromBeacon not mocked")
+		return
+	}
+
+	return m.ChainGetRandomnessFromBeaconFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockProxy) ChainGetRandomnessFromTickets(in0 context.Context, in1 types.TipSetKey, in2 crypto.DomainSeparationTag, in3 abi.ChainEpoch, in4 []uint8) (out0 abi.Randomness, err error) {
+	m.record("ChainGetRandomnessFromTickets", in1, in2, in3, in4)
+	if m.ChainGetRandomnessFromTicketsFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetRandomnessFromTickets not mocked")
+		return
+	}
+
+	return m.ChainGetRandomnessFromTicketsFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockProxy) ChainGetTipSet(in0 context.Context, in1 types.TipSetKey) (out0 *types.TipSet, err error) {
+	m.record("ChainGetTipSet", in1)
+	if m.ChainGetTipSetFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetTipSet not mocked")
+		return
+	}
+
+	return m.ChainGetTipSetFunc(in0, in1)
+}
+
+func (m *MockProxy) ChainGetTipSetByHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
+	m.record("ChainGetTipSetByHeight", in1, in2)
+	if m.ChainGetTipSetByHeightFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainGetTipSetByHeight not mocked")
+		return
+	}
+
+	return m.ChainGetTipSetByHeightFunc(in0, in1, in2)
+}
+
+func (m *MockProxy) ChainHead(in0 context.Context) (out0 *types.TipSet, err error) {
+	m.record("ChainHead")
+	if m.ChainHeadFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainHead not mocked")
+		return
+	}
+
+	return m.ChainHeadFunc(in0)
+}
+
+func (m *MockProxy) ChainTipSetWeight(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("ChainTipSetWeight", in1)
+	if m.ChainTipSetWeightFunc == nil {
+		err = fmt.Errorf("MockProxy.ChainTipSetWeight not mocked")
+		return
+	}
+
+	return m.ChainTipSetWeightFunc(in0, in1)
+}
+
+func (m *MockProxy) WalletBalance(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
+	m.record("WalletBalance", in1)
+	if m.WalletBalanceFunc == nil {
+		err = fmt.Errorf("MockProxy.WalletBalance not mocked")
+		return
+	}
+
+	return m.WalletBalanceFunc(in0, in1)
+}
+
+func (m *MockProxy) WalletValidateAddress(in0 context.Context, in1 string) (out0 address.Address, err error) {
+	m.record("WalletValidateAddress", in1)
+	if m.WalletValidateAddressFunc == nil {
+		err = fmt.Errorf("MockProxy.WalletValidateAddress not mocked")
+		return
+	}
+
+	return m.WalletValidateAddressFunc(in0, in1)
+}
+
+func (m *MockProxy) WalletVerify(in0 context.Context, in1 address.Address, in2 []uint8, in3 *crypto.Signature) (out0 bool, err error) {
+	m.record("WalletVerify", in1, in2, in3)
+	if m.WalletVerifyFunc == nil {
+		err = fmt.Errorf("MockProxy.WalletVerify not mocked")
+		return
+	}
+
+	return m.WalletVerifyFunc(in0, in1, in2, in3)
+}
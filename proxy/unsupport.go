@@ -11,7 +11,6 @@ import (
 	"github.com/filecoin-project/go-multistore"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/go-state-types/big"
-	"github.com/filecoin-project/go-state-types/crypto"
 	"github.com/filecoin-project/go-state-types/dline"
 	network1 "github.com/filecoin-project/go-state-types/network"
 	api1 "github.com/filecoin-project/lotus/api"
@@ -36,1470 +35,3512 @@ type UnSupportAPI interface {
 }
 
 type UnSupport struct {
-	Select func() (UnSupportAPI, error)
+	// Select chooses the upstream for the method, args are the params except the context
+	Select func(ctx context.Context, method string, args ...interface{}) (UnSupportAPI, error)
+
+	// Wrap is optional, it will be called around each call to the upstream if set.
+	// The call selects the upstream and calls it, the results are only set by the returned func,
+	// so that the call can be made more than once, even concurrently, and one of the results is taken
+	Wrap func(ctx context.Context, method string, args []interface{}, call func(context.Context) (commit func(), err error)) error
+}
+
+// UnSupportPerms maps the methods to the required permissions
+var UnSupportPerms = map[string]string{
+	"AuthNew":                   "admin",
+	"AuthVerify":                "read",
+	"ChainDeleteObj":            "admin",
+	"ChainExport":               "read",
+	"ChainGetNode":              "read",
+	"ChainGetPath":              "read",
+	"ChainHasObj":               "read",
+	"ChainReadObj":              "read",
+	"ChainSetHead":              "admin",
+	"ChainStatObj":              "read",
+	"ClientCalcCommP":           "read",
+	"ClientCancelDataTransfer":  "write",
+	"ClientDataTransferUpdates": "write",
+	"ClientDealPieceCID":        "read",
+	"ClientDealSize":            "read",
+	"ClientFindData":            "read",
+	"ClientGenCar":              "write",
+	"ClientGetDealInfo":         "read",
+	"ClientGetDealStatus":       "read",
+	"ClientGetDealUpdates":      "read",
+	"ClientHasLocal":            "write",
+	"ClientImport":              "admin",
+	"ClientListDataTransfers":   "write",
+	"ClientListDeals":           "write",
+	"ClientListImports":         "write",
+	"ClientMinerQueryOffer":     "read",
+	"ClientQueryAsk":            "read",
+	"ClientRemoveImport":        "admin",
+	"ClientRestartDataTransfer": "write",
+	"ClientRetrieve":            "admin",
+	"ClientRetrieveTryRestartInsufficientFunds": "write",
+	"ClientRetrieveWithEvents":                  "admin",
+	"ClientStartDeal":                           "admin",
+	"Closing":                                   "read",
+	"CreateBackup":                              "admin",
+	"ID":                                        "read",
+	"LogList":                                   "write",
+	"LogSetLevel":                               "write",
+	"MarketAddBalance":                          "sign",
+	"MarketGetReserved":                         "sign",
+	"MarketReleaseFunds":                        "sign",
+	"MarketReserveFunds":                        "sign",
+	"MarketWithdraw":                            "sign",
+	"MpoolClear":                                "write",
+	"MpoolGetConfig":                            "read",
+	"MpoolSelect":                               "read",
+	"MpoolSetConfig":                            "write",
+	"MsigAddApprove":                            "sign",
+	"MsigAddCancel":                             "sign",
+	"MsigAddPropose":                            "sign",
+	"MsigApprove":                               "sign",
+	"MsigApproveTxnHash":                        "sign",
+	"MsigCancel":                                "sign",
+	"MsigCreate":                                "sign",
+	"MsigGetAvailableBalance":                   "read",
+	"MsigGetPending":                            "read",
+	"MsigGetVested":                             "read",
+	"MsigGetVestingSchedule":                    "read",
+	"MsigPropose":                               "sign",
+	"MsigRemoveSigner":                          "sign",
+	"MsigSwapApprove":                           "sign",
+	"MsigSwapCancel":                            "sign",
+	"MsigSwapPropose":                           "sign",
+	"NetAddrsListen":                            "read",
+	"NetAgentVersion":                           "read",
+	"NetAutoNatStatus":                          "read",
+	"NetBandwidthStats":                         "read",
+	"NetBandwidthStatsByPeer":                   "read",
+	"NetBandwidthStatsByProtocol":               "read",
+	"NetBlockAdd":                               "admin",
+	"NetBlockList":                              "read",
+	"NetBlockRemove":                            "admin",
+	"NetConnect":                                "write",
+	"NetConnectedness":                          "read",
+	"NetDisconnect":                             "write",
+	"NetFindPeer":                               "read",
+	"NetPeerInfo":                               "read",
+	"NetPeers":                                  "read",
+	"NetPubsubScores":                           "read",
+	"PaychAllocateLane":                         "sign",
+	"PaychAvailableFunds":                       "sign",
+	"PaychAvailableFundsByFromTo":               "sign",
+	"PaychCollect":                              "sign",
+	"PaychGet":                                  "sign",
+	"PaychGetWaitReady":                         "sign",
+	"PaychList":                                 "read",
+	"PaychNewPayment":                           "sign",
+	"PaychSettle":                               "sign",
+	"PaychStatus":                               "read",
+	"PaychVoucherAdd":                           "write",
+	"PaychVoucherCheckSpendable":                "read",
+	"PaychVoucherCheckValid":                    "read",
+	"PaychVoucherCreate":                        "sign",
+	"PaychVoucherList":                          "write",
+	"PaychVoucherSubmit":                        "sign",
+	"Session":                                   "read",
+	"Shutdown":                                  "admin",
+	"StateAccountKey":                           "read",
+	"StateAllMinerFaults":                       "read",
+	"StateCall":                                 "read",
+	"StateChangedActors":                        "read",
+	"StateCirculatingSupply":                    "read",
+	"StateCompute":                              "read",
+	"StateDealProviderCollateralBounds":         "read",
+	"StateDecodeParams":                         "read",
+	"StateGetActor":                             "read",
+	"StateGetReceipt":                           "read",
+	"StateListActors":                           "read",
+	"StateListMessages":                         "read",
+	"StateListMiners":                           "read",
+	"StateLookupID":                             "read",
+	"StateMarketBalance":                        "read",
+	"StateMarketDeals":                          "read",
+	"StateMarketParticipants":                   "read",
+	"StateMarketStorageDeal":                    "read",
+	"StateMinerActiveSectors":                   "read",
+	"StateMinerAvailableBalance":                "read",
+	"StateMinerDeadlines":                       "read",
+	"StateMinerFaults":                          "read",
+	"StateMinerInfo":                            "read",
+	"StateMinerInitialPledgeCollateral":         "read",
+	"StateMinerPartitions":                      "read",
+	"StateMinerPower":                           "read",
+	"StateMinerPreCommitDepositForPower":        "read",
+	"StateMinerProvingDeadline":                 "read",
+	"StateMinerRecoveries":                      "read",
+	"StateMinerSectorAllocated":                 "read",
+	"StateMinerSectorCount":                     "read",
+	"StateMinerSectors":                         "read",
+	"StateNetworkName":                          "read",
+	"StateNetworkVersion":                       "read",
+	"StateReadState":                            "read",
+	"StateReplay":                               "read",
+	"StateSearchMsg":                            "read",
+	"StateSearchMsgLimited":                     "read",
+	"StateSectorExpiration":                     "read",
+	"StateSectorGetInfo":                        "read",
+	"StateSectorPartition":                      "read",
+	"StateSectorPreCommitInfo":                  "read",
+	"StateVMCirculatingSupplyInternal":          "read",
+	"StateVerifiedClientStatus":                 "read",
+	"StateVerifiedRegistryRootKey":              "read",
+	"StateVerifierStatus":                       "read",
+	"StateWaitMsg":                              "read",
+	"StateWaitMsgLimited":                       "read",
+	"SyncCheckBad":                              "read",
+	"SyncCheckpoint":                            "admin",
+	"SyncIncomingBlocks":                        "read",
+	"SyncMarkBad":                               "admin",
+	"SyncUnmarkAllBad":                          "admin",
+	"SyncUnmarkBad":                             "admin",
+	"SyncValidateTipset":                        "read",
+	"Version":                                   "read",
 }
 
 // impl api.UnSupport
 func (p *UnSupport) AuthNew(in0 context.Context, in1 []auth.Permission) (out0 []uint8, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "AuthNew", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "AuthNew", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.AuthNew(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "AuthNew", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.AuthNew(in0, in1)
 }
 
 func (p *UnSupport) AuthVerify(in0 context.Context, in1 string) (out0 []auth.Permission, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "AuthVerify", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "AuthVerify", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.AuthVerify(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "AuthVerify", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.AuthVerify(in0, in1)
 }
 
 func (p *UnSupport) ChainDeleteObj(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainDeleteObj", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainDeleteObj", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ChainDeleteObj(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainDeleteObj", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainDeleteObj(in0, in1)
 }
 
 func (p *UnSupport) ChainExport(in0 context.Context, in1 abi.ChainEpoch, in2 bool, in3 types.TipSetKey) (out0 <-chan []uint8, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainExport", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainExport", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainExport(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainExport", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainExport(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) ChainGetNode(in0 context.Context, in1 string) (out0 *api1.IpldObject, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetNode", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetNode", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetNode(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetNode", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetNode(in0, in1)
 }
 
 func (p *UnSupport) ChainGetPath(in0 context.Context, in1 types.TipSetKey, in2 types.TipSetKey) (out0 []*api1.HeadChange, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainGetPath", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainGetPath", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainGetPath(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainGetPath", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainGetPath(in0, in1, in2)
 }
 
 func (p *UnSupport) ChainHasObj(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainHasObj", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainHasObj", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainHasObj(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainHasObj", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainHasObj(in0, in1)
 }
 
 func (p *UnSupport) ChainReadObj(in0 context.Context, in1 cid.Cid) (out0 []uint8, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainReadObj", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainReadObj", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainReadObj(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainReadObj", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainReadObj(in0, in1)
 }
 
 func (p *UnSupport) ChainSetHead(in0 context.Context, in1 types.TipSetKey) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainSetHead", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainSetHead", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ChainSetHead(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainSetHead", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainSetHead(in0, in1)
 }
 
 func (p *UnSupport) ChainStatObj(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 api1.ObjStat, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ChainStatObj", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ChainStatObj", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ChainStatObj(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ChainStatObj", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ChainStatObj(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientCalcCommP(in0 context.Context, in1 string) (out0 *api1.CommPRet, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientCalcCommP", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientCalcCommP", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientCalcCommP(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientCalcCommP", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientCalcCommP(in0, in1)
 }
 
 func (p *UnSupport) ClientCancelDataTransfer(in0 context.Context, in1 datatransfer.TransferID, in2 peer.ID, in3 bool) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientCancelDataTransfer", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientCancelDataTransfer", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientCancelDataTransfer(in0, in1, in2, in3)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientCancelDataTransfer", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientCancelDataTransfer(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) ClientDataTransferUpdates(in0 context.Context) (out0 <-chan api1.DataTransferChannel, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientDataTransferUpdates", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientDataTransferUpdates")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientDataTransferUpdates(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientDataTransferUpdates")
 	if err != nil {
 		return
 	}
+
 	return cli.ClientDataTransferUpdates(in0)
 }
 
 func (p *UnSupport) ClientDealPieceCID(in0 context.Context, in1 cid.Cid) (out0 api1.DataCIDSize, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientDealPieceCID", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientDealPieceCID", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientDealPieceCID(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientDealPieceCID", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientDealPieceCID(in0, in1)
 }
 
 func (p *UnSupport) ClientDealSize(in0 context.Context, in1 cid.Cid) (out0 api1.DataSize, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientDealSize", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientDealSize", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientDealSize(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientDealSize", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientDealSize(in0, in1)
 }
 
 func (p *UnSupport) ClientFindData(in0 context.Context, in1 cid.Cid, in2 *cid.Cid) (out0 []api1.QueryOffer, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientFindData", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientFindData", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientFindData(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientFindData", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientFindData(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientGenCar(in0 context.Context, in1 api1.FileRef, in2 string) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientGenCar", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientGenCar", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientGenCar(in0, in1, in2)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientGenCar", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientGenCar(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientGetDealInfo(in0 context.Context, in1 cid.Cid) (out0 *api1.DealInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientGetDealInfo", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientGetDealInfo", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientGetDealInfo(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientGetDealInfo", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientGetDealInfo(in0, in1)
 }
 
 func (p *UnSupport) ClientGetDealStatus(in0 context.Context, in1 uint64) (out0 string, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientGetDealStatus", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientGetDealStatus", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientGetDealStatus(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientGetDealStatus", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientGetDealStatus(in0, in1)
 }
 
 func (p *UnSupport) ClientGetDealUpdates(in0 context.Context) (out0 <-chan api1.DealInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientGetDealUpdates", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientGetDealUpdates")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientGetDealUpdates(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientGetDealUpdates")
 	if err != nil {
 		return
 	}
+
 	return cli.ClientGetDealUpdates(in0)
 }
 
 func (p *UnSupport) ClientHasLocal(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientHasLocal", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientHasLocal", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientHasLocal(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientHasLocal", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientHasLocal(in0, in1)
 }
 
 func (p *UnSupport) ClientImport(in0 context.Context, in1 api1.FileRef) (out0 *api1.ImportRes, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientImport", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientImport", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientImport(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientImport", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientImport(in0, in1)
 }
 
 func (p *UnSupport) ClientListDataTransfers(in0 context.Context) (out0 []api1.DataTransferChannel, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientListDataTransfers", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientListDataTransfers")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientListDataTransfers(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientListDataTransfers")
 	if err != nil {
 		return
 	}
+
 	return cli.ClientListDataTransfers(in0)
 }
 
 func (p *UnSupport) ClientListDeals(in0 context.Context) (out0 []api1.DealInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientListDeals", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientListDeals")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientListDeals(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientListDeals")
 	if err != nil {
 		return
 	}
+
 	return cli.ClientListDeals(in0)
 }
 
 func (p *UnSupport) ClientListImports(in0 context.Context) (out0 []api1.Import, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientListImports", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientListImports")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientListImports(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientListImports")
 	if err != nil {
 		return
 	}
+
 	return cli.ClientListImports(in0)
 }
 
 func (p *UnSupport) ClientMinerQueryOffer(in0 context.Context, in1 address.Address, in2 cid.Cid, in3 *cid.Cid) (out0 api1.QueryOffer, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientMinerQueryOffer", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientMinerQueryOffer", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientMinerQueryOffer(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientMinerQueryOffer", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientMinerQueryOffer(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) ClientQueryAsk(in0 context.Context, in1 peer.ID, in2 address.Address) (out0 *storagemarket.StorageAsk, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientQueryAsk", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientQueryAsk", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientQueryAsk(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientQueryAsk", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientQueryAsk(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientRemoveImport(in0 context.Context, in1 multistore.StoreID) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientRemoveImport", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientRemoveImport", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientRemoveImport(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientRemoveImport", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientRemoveImport(in0, in1)
 }
 
 func (p *UnSupport) ClientRestartDataTransfer(in0 context.Context, in1 datatransfer.TransferID, in2 peer.ID, in3 bool) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientRestartDataTransfer", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientRestartDataTransfer", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientRestartDataTransfer(in0, in1, in2, in3)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientRestartDataTransfer", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientRestartDataTransfer(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) ClientRetrieve(in0 context.Context, in1 api1.RetrievalOrder, in2 *api1.FileRef) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientRetrieve", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientRetrieve", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientRetrieve(in0, in1, in2)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientRetrieve", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientRetrieve(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientRetrieveTryRestartInsufficientFunds(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientRetrieveTryRestartInsufficientFunds", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientRetrieveTryRestartInsufficientFunds", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.ClientRetrieveTryRestartInsufficientFunds(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientRetrieveTryRestartInsufficientFunds", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientRetrieveTryRestartInsufficientFunds(in0, in1)
 }
 
 func (p *UnSupport) ClientRetrieveWithEvents(in0 context.Context, in1 api1.RetrievalOrder, in2 *api1.FileRef) (out0 <-chan marketevents.RetrievalEvent, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientRetrieveWithEvents", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientRetrieveWithEvents", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientRetrieveWithEvents(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "ClientRetrieveWithEvents", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.ClientRetrieveWithEvents(in0, in1, in2)
 }
 
 func (p *UnSupport) ClientStartDeal(in0 context.Context, in1 *api1.StartDealParams) (out0 *cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ClientStartDeal", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ClientStartDeal", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ClientStartDeal(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.ClientStartDeal(in0, in1)
-}
 
-func (p *UnSupport) Closing(in0 context.Context) (out0 <-chan struct{}, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "ClientStartDeal", in1)
 	if err != nil {
 		return
 	}
-	return cli.Closing(in0)
+
+	return cli.ClientStartDeal(in0, in1)
 }
 
-func (p *UnSupport) CreateBackup(in0 context.Context, in1 string) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) Closing(in0 context.Context) (out0 <-chan struct{}, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "Closing", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "Closing")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.Closing(in0)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.CreateBackup(in0, in1)
-}
 
-func (p *UnSupport) GasEstimateFeeCap(in0 context.Context, in1 *types.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "Closing")
 	if err != nil {
 		return
 	}
-	return cli.GasEstimateFeeCap(in0, in1, in2, in3)
+
+	return cli.Closing(in0)
 }
 
-func (p *UnSupport) GasEstimateGasLimit(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 int64, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) CreateBackup(in0 context.Context, in1 string) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "CreateBackup", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "CreateBackup", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.CreateBackup(in0, in1)
+		})
 		return
 	}
-	return cli.GasEstimateGasLimit(in0, in1, in2)
-}
 
-func (p *UnSupport) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "CreateBackup", in1)
 	if err != nil {
 		return
 	}
-	return cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
+
+	return cli.CreateBackup(in0, in1)
 }
 
-func (p *UnSupport) GasEstimateMessageGas(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec, in3 types.TipSetKey) (out0 *types.Message, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) ID(in0 context.Context) (out0 peer.ID, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "ID", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "ID")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.ID(in0)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.GasEstimateMessageGas(in0, in1, in2, in3)
-}
 
-func (p *UnSupport) ID(in0 context.Context) (out0 peer.ID, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "ID")
 	if err != nil {
 		return
 	}
+
 	return cli.ID(in0)
 }
 
 func (p *UnSupport) LogList(in0 context.Context) (out0 []string, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "LogList", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "LogList")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.LogList(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "LogList")
 	if err != nil {
 		return
 	}
+
 	return cli.LogList(in0)
 }
 
 func (p *UnSupport) LogSetLevel(in0 context.Context, in1 string, in2 string) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "LogSetLevel", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "LogSetLevel", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.LogSetLevel(in0, in1, in2)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "LogSetLevel", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.LogSetLevel(in0, in1, in2)
 }
 
 func (p *UnSupport) MarketAddBalance(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MarketAddBalance", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MarketAddBalance", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MarketAddBalance(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MarketAddBalance", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.MarketAddBalance(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) MarketGetReserved(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MarketGetReserved", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MarketGetReserved", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MarketGetReserved(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MarketGetReserved", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.MarketGetReserved(in0, in1)
 }
 
 func (p *UnSupport) MarketReleaseFunds(in0 context.Context, in1 address.Address, in2 big.Int) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MarketReleaseFunds", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MarketReleaseFunds", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.MarketReleaseFunds(in0, in1, in2)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MarketReleaseFunds", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.MarketReleaseFunds(in0, in1, in2)
 }
 
 func (p *UnSupport) MarketReserveFunds(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MarketReserveFunds", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MarketReserveFunds", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MarketReserveFunds(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MarketReserveFunds", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.MarketReserveFunds(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) MarketWithdraw(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MarketWithdraw", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MarketWithdraw", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MarketWithdraw(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MarketWithdraw(in0, in1, in2, in3)
-}
 
-func (p *UnSupport) MinerCreateBlock(in0 context.Context, in1 *api1.BlockTemplate) (out0 *types.BlockMsg, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MarketWithdraw", in1, in2, in3)
 	if err != nil {
 		return
 	}
-	return cli.MinerCreateBlock(in0, in1)
+
+	return cli.MarketWithdraw(in0, in1, in2, in3)
 }
 
-func (p *UnSupport) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 abi.ChainEpoch, in3 types.TipSetKey) (out0 *api1.MiningBaseInfo, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolClear", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolClear", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.MpoolClear(in0, in1)
+		})
 		return
 	}
-	return cli.MinerGetBaseInfo(in0, in1, in2, in3)
-}
 
-func (p *UnSupport) MpoolBatchPush(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MpoolClear", in1)
 	if err != nil {
 		return
 	}
-	return cli.MpoolBatchPush(in0, in1)
+
+	return cli.MpoolClear(in0, in1)
 }
 
-func (p *UnSupport) MpoolBatchPushMessage(in0 context.Context, in1 []*types.Message, in2 *api1.MessageSendSpec) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolGetConfig", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolGetConfig")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolGetConfig(in0)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MpoolBatchPushMessage(in0, in1, in2)
-}
 
-func (p *UnSupport) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MpoolGetConfig")
 	if err != nil {
 		return
 	}
-	return cli.MpoolBatchPushUntrusted(in0, in1)
+
+	return cli.MpoolGetConfig(in0)
 }
 
-func (p *UnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float64) (out0 []*types.SignedMessage, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolSelect", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolSelect", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolSelect(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MpoolClear(in0, in1)
-}
 
-func (p *UnSupport) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MpoolSelect", in1, in2)
 	if err != nil {
 		return
 	}
-	return cli.MpoolGetConfig(in0)
+
+	return cli.MpoolSelect(in0, in1, in2)
 }
 
-func (p *UnSupport) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolSetConfig", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolSetConfig", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.MpoolSetConfig(in0, in1)
+		})
 		return
 	}
-	return cli.MpoolGetNonce(in0, in1)
-}
 
-func (p *UnSupport) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MpoolSetConfig", in1)
 	if err != nil {
 		return
 	}
-	return cli.MpoolPending(in0, in1)
+
+	return cli.MpoolSetConfig(in0, in1)
 }
 
-func (p *UnSupport) MpoolPush(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MsigAddApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 bool) (out0 cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigAddApprove", []interface{}{in1, in2, in3, in4, in5, in6}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigAddApprove", in1, in2, in3, in4, in5, in6)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigAddApprove(in0, in1, in2, in3, in4, in5, in6)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MpoolPush(in0, in1)
-}
 
-func (p *UnSupport) MpoolPushMessage(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec) (out0 *types.SignedMessage, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MsigAddApprove", in1, in2, in3, in4, in5, in6)
 	if err != nil {
 		return
 	}
-	return cli.MpoolPushMessage(in0, in1, in2)
-}
 
-func (p *UnSupport) MpoolPushUntrusted(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
-		return
-	}
-	return cli.MpoolPushUntrusted(in0, in1)
+	return cli.MsigAddApprove(in0, in1, in2, in3, in4, in5, in6)
 }
 
-func (p *UnSupport) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float64) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select()
-	if err != nil {
-		return
-	}
-	return cli.MpoolSelect(in0, in1, in2)
-}
+func (p *UnSupport) MsigAddCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 bool) (out0 cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigAddCancel", []interface{}{in1, in2, in3, in4, in5}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigAddCancel", in1, in2, in3, in4, in5)
+			if err != nil {
+				return nil, err
+			}
 
-func (p *UnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+			ret0, err := cli.MsigAddCancel(in0, in1, in2, in3, in4, in5)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MpoolSetConfig(in0, in1)
-}
 
-func (p *UnSupport) MpoolSub(in0 context.Context) (out0 <-chan api1.MpoolUpdate, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MsigAddCancel", in1, in2, in3, in4, in5)
 	if err != nil {
 		return
 	}
-	return cli.MpoolSub(in0)
-}
 
-func (p *UnSupport) MsigAddApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 bool) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
-		return
-	}
-	return cli.MsigAddApprove(in0, in1, in2, in3, in4, in5, in6)
+	return cli.MsigAddCancel(in0, in1, in2, in3, in4, in5)
 }
 
-func (p *UnSupport) MsigAddCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 bool) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) MsigAddPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigAddPropose", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigAddPropose", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigAddPropose(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.MsigAddCancel(in0, in1, in2, in3, in4, in5)
-}
 
-func (p *UnSupport) MsigAddPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "MsigAddPropose", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigAddPropose(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) MsigApprove(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigApprove", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigApprove", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigApprove(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigApprove", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigApprove(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) MsigApproveTxnHash(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 address.Address, in5 big.Int, in6 address.Address, in7 uint64, in8 []uint8) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigApproveTxnHash", []interface{}{in1, in2, in3, in4, in5, in6, in7, in8}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigApproveTxnHash", in1, in2, in3, in4, in5, in6, in7, in8)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigApproveTxnHash(in0, in1, in2, in3, in4, in5, in6, in7, in8)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigApproveTxnHash", in1, in2, in3, in4, in5, in6, in7, in8)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigApproveTxnHash(in0, in1, in2, in3, in4, in5, in6, in7, in8)
 }
 
 func (p *UnSupport) MsigCancel(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 big.Int, in5 address.Address, in6 uint64, in7 []uint8) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigCancel", []interface{}{in1, in2, in3, in4, in5, in6, in7}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigCancel", in1, in2, in3, in4, in5, in6, in7)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigCancel(in0, in1, in2, in3, in4, in5, in6, in7)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigCancel", in1, in2, in3, in4, in5, in6, in7)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigCancel(in0, in1, in2, in3, in4, in5, in6, in7)
 }
 
 func (p *UnSupport) MsigCreate(in0 context.Context, in1 uint64, in2 []address.Address, in3 abi.ChainEpoch, in4 big.Int, in5 address.Address, in6 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigCreate", []interface{}{in1, in2, in3, in4, in5, in6}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigCreate", in1, in2, in3, in4, in5, in6)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigCreate(in0, in1, in2, in3, in4, in5, in6)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigCreate", in1, in2, in3, in4, in5, in6)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigCreate(in0, in1, in2, in3, in4, in5, in6)
 }
 
 func (p *UnSupport) MsigGetAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigGetAvailableBalance", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigGetAvailableBalance", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigGetAvailableBalance(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigGetAvailableBalance", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigGetAvailableBalance(in0, in1, in2)
 }
 
 func (p *UnSupport) MsigGetPending(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*api1.MsigTransaction, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigGetPending", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigGetPending", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigGetPending(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigGetPending", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigGetPending(in0, in1, in2)
 }
 
 func (p *UnSupport) MsigGetVested(in0 context.Context, in1 address.Address, in2 types.TipSetKey, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigGetVested", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigGetVested", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigGetVested(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigGetVested", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigGetVested(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) MsigGetVestingSchedule(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MsigVesting, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigGetVestingSchedule", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigGetVestingSchedule", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigGetVestingSchedule(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigGetVestingSchedule", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigGetVestingSchedule(in0, in1, in2)
 }
 
 func (p *UnSupport) MsigPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int, in4 address.Address, in5 uint64, in6 []uint8) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigPropose", []interface{}{in1, in2, in3, in4, in5, in6}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigPropose", in1, in2, in3, in4, in5, in6)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigPropose(in0, in1, in2, in3, in4, in5, in6)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigPropose", in1, in2, in3, in4, in5, in6)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigPropose(in0, in1, in2, in3, in4, in5, in6)
 }
 
 func (p *UnSupport) MsigRemoveSigner(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigRemoveSigner", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigRemoveSigner", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigRemoveSigner(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigRemoveSigner", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigRemoveSigner(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) MsigSwapApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigSwapApprove", []interface{}{in1, in2, in3, in4, in5, in6}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigSwapApprove", in1, in2, in3, in4, in5, in6)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigSwapApprove(in0, in1, in2, in3, in4, in5, in6)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigSwapApprove", in1, in2, in3, in4, in5, in6)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigSwapApprove(in0, in1, in2, in3, in4, in5, in6)
 }
 
 func (p *UnSupport) MsigSwapCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigSwapCancel", []interface{}{in1, in2, in3, in4, in5}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigSwapCancel", in1, in2, in3, in4, in5)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigSwapCancel(in0, in1, in2, in3, in4, in5)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigSwapCancel", in1, in2, in3, in4, in5)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigSwapCancel(in0, in1, in2, in3, in4, in5)
 }
 
 func (p *UnSupport) MsigSwapPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MsigSwapPropose", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MsigSwapPropose", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MsigSwapPropose(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MsigSwapPropose", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.MsigSwapPropose(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) NetAddrsListen(in0 context.Context) (out0 peer.AddrInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetAddrsListen", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetAddrsListen")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetAddrsListen(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetAddrsListen")
 	if err != nil {
 		return
 	}
+
 	return cli.NetAddrsListen(in0)
 }
 
 func (p *UnSupport) NetAgentVersion(in0 context.Context, in1 peer.ID) (out0 string, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetAgentVersion", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetAgentVersion", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetAgentVersion(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetAgentVersion", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetAgentVersion(in0, in1)
 }
 
 func (p *UnSupport) NetAutoNatStatus(in0 context.Context) (out0 api1.NatInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetAutoNatStatus", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetAutoNatStatus")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetAutoNatStatus(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetAutoNatStatus")
 	if err != nil {
 		return
 	}
+
 	return cli.NetAutoNatStatus(in0)
 }
 
 func (p *UnSupport) NetBandwidthStats(in0 context.Context) (out0 metrics.Stats, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBandwidthStats", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBandwidthStats")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetBandwidthStats(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBandwidthStats")
 	if err != nil {
 		return
 	}
+
 	return cli.NetBandwidthStats(in0)
 }
 
 func (p *UnSupport) NetBandwidthStatsByPeer(in0 context.Context) (out0 map[string]metrics.Stats, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBandwidthStatsByPeer", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBandwidthStatsByPeer")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetBandwidthStatsByPeer(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBandwidthStatsByPeer")
 	if err != nil {
 		return
 	}
+
 	return cli.NetBandwidthStatsByPeer(in0)
 }
 
 func (p *UnSupport) NetBandwidthStatsByProtocol(in0 context.Context) (out0 map[protocol.ID]metrics.Stats, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBandwidthStatsByProtocol", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBandwidthStatsByProtocol")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetBandwidthStatsByProtocol(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBandwidthStatsByProtocol")
 	if err != nil {
 		return
 	}
+
 	return cli.NetBandwidthStatsByProtocol(in0)
 }
 
 func (p *UnSupport) NetBlockAdd(in0 context.Context, in1 api1.NetBlockList) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBlockAdd", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBlockAdd", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.NetBlockAdd(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBlockAdd", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetBlockAdd(in0, in1)
 }
 
 func (p *UnSupport) NetBlockList(in0 context.Context) (out0 api1.NetBlockList, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBlockList", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBlockList")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetBlockList(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBlockList")
 	if err != nil {
 		return
 	}
+
 	return cli.NetBlockList(in0)
 }
 
 func (p *UnSupport) NetBlockRemove(in0 context.Context, in1 api1.NetBlockList) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetBlockRemove", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetBlockRemove", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.NetBlockRemove(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetBlockRemove", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetBlockRemove(in0, in1)
 }
 
 func (p *UnSupport) NetConnect(in0 context.Context, in1 peer.AddrInfo) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetConnect", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetConnect", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.NetConnect(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetConnect", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetConnect(in0, in1)
 }
 
 func (p *UnSupport) NetConnectedness(in0 context.Context, in1 peer.ID) (out0 network.Connectedness, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetConnectedness", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetConnectedness", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetConnectedness(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetConnectedness", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetConnectedness(in0, in1)
 }
 
 func (p *UnSupport) NetDisconnect(in0 context.Context, in1 peer.ID) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetDisconnect", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetDisconnect", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.NetDisconnect(in0, in1)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetDisconnect", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetDisconnect(in0, in1)
 }
 
 func (p *UnSupport) NetFindPeer(in0 context.Context, in1 peer.ID) (out0 peer.AddrInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetFindPeer", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetFindPeer", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetFindPeer(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetFindPeer", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetFindPeer(in0, in1)
 }
 
 func (p *UnSupport) NetPeerInfo(in0 context.Context, in1 peer.ID) (out0 *api1.ExtendedPeerInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetPeerInfo", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetPeerInfo", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetPeerInfo(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetPeerInfo", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.NetPeerInfo(in0, in1)
 }
 
 func (p *UnSupport) NetPeers(in0 context.Context) (out0 []peer.AddrInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetPeers", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetPeers")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetPeers(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetPeers")
 	if err != nil {
 		return
 	}
+
 	return cli.NetPeers(in0)
 }
 
 func (p *UnSupport) NetPubsubScores(in0 context.Context) (out0 []api1.PubsubScore, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "NetPubsubScores", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "NetPubsubScores")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.NetPubsubScores(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "NetPubsubScores")
 	if err != nil {
 		return
 	}
+
 	return cli.NetPubsubScores(in0)
 }
 
 func (p *UnSupport) PaychAllocateLane(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychAllocateLane", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychAllocateLane", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychAllocateLane(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychAllocateLane", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychAllocateLane(in0, in1)
 }
 
 func (p *UnSupport) PaychAvailableFunds(in0 context.Context, in1 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychAvailableFunds", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychAvailableFunds", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychAvailableFunds(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychAvailableFunds", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychAvailableFunds(in0, in1)
 }
 
 func (p *UnSupport) PaychAvailableFundsByFromTo(in0 context.Context, in1 address.Address, in2 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychAvailableFundsByFromTo", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychAvailableFundsByFromTo", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychAvailableFundsByFromTo(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychAvailableFundsByFromTo", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychAvailableFundsByFromTo(in0, in1, in2)
 }
 
 func (p *UnSupport) PaychCollect(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychCollect", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychCollect", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychCollect(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychCollect", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychCollect(in0, in1)
 }
 
 func (p *UnSupport) PaychGet(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 *api1.ChannelInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychGet", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychGet", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychGet(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychGet", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychGet(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) PaychGetWaitReady(in0 context.Context, in1 cid.Cid) (out0 address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychGetWaitReady", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychGetWaitReady", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychGetWaitReady(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychGetWaitReady", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychGetWaitReady(in0, in1)
 }
 
 func (p *UnSupport) PaychList(in0 context.Context) (out0 []address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychList", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychList")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychList(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychList")
 	if err != nil {
 		return
 	}
+
 	return cli.PaychList(in0)
 }
 
 func (p *UnSupport) PaychNewPayment(in0 context.Context, in1 address.Address, in2 address.Address, in3 []api1.VoucherSpec) (out0 *api1.PaymentInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychNewPayment", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychNewPayment", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychNewPayment(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychNewPayment", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychNewPayment(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) PaychSettle(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychSettle", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychSettle", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychSettle(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychSettle", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychSettle(in0, in1)
 }
 
 func (p *UnSupport) PaychStatus(in0 context.Context, in1 address.Address) (out0 *api1.PaychStatus, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychStatus", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychStatus", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychStatus(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychStatus", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychStatus(in0, in1)
 }
 
 func (p *UnSupport) PaychVoucherAdd(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 big.Int) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherAdd", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherAdd", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychVoucherAdd(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherAdd", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherAdd(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) PaychVoucherCheckSpendable(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 bool, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherCheckSpendable", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherCheckSpendable", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychVoucherCheckSpendable(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherCheckSpendable", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherCheckSpendable(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) PaychVoucherCheckValid(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherCheckValid", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherCheckValid", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.PaychVoucherCheckValid(in0, in1, in2)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherCheckValid", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherCheckValid(in0, in1, in2)
 }
 
 func (p *UnSupport) PaychVoucherCreate(in0 context.Context, in1 address.Address, in2 big.Int, in3 uint64) (out0 *api1.VoucherCreateResult, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherCreate", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherCreate", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychVoucherCreate(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherCreate", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherCreate(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) PaychVoucherList(in0 context.Context, in1 address.Address) (out0 []*paych.SignedVoucher, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherList", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherList", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychVoucherList(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherList", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherList(in0, in1)
 }
 
 func (p *UnSupport) PaychVoucherSubmit(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "PaychVoucherSubmit", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "PaychVoucherSubmit", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.PaychVoucherSubmit(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "PaychVoucherSubmit", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.PaychVoucherSubmit(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) Session(in0 context.Context) (out0 uuid.UUID, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "Session", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "Session")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.Session(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "Session")
 	if err != nil {
 		return
 	}
+
 	return cli.Session(in0)
 }
 
 func (p *UnSupport) Shutdown(in0 context.Context) (err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "Shutdown", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "Shutdown")
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.Shutdown(in0)
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "Shutdown")
 	if err != nil {
 		return
 	}
+
 	return cli.Shutdown(in0)
 }
 
 func (p *UnSupport) StateAccountKey(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateAccountKey", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateAccountKey", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateAccountKey(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateAccountKey", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateAccountKey(in0, in1, in2)
 }
 
 func (p *UnSupport) StateAllMinerFaults(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 []*api1.Fault, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateAllMinerFaults", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateAllMinerFaults", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateAllMinerFaults(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateAllMinerFaults", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateAllMinerFaults(in0, in1, in2)
 }
 
 func (p *UnSupport) StateCall(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 *api1.InvocResult, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateCall", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateCall", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateCall(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateCall", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateCall(in0, in1, in2)
 }
 
 func (p *UnSupport) StateChangedActors(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 map[string]types.Actor, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateChangedActors", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateChangedActors", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateChangedActors(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateChangedActors", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateChangedActors(in0, in1, in2)
 }
 
 func (p *UnSupport) StateCirculatingSupply(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateCirculatingSupply", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateCirculatingSupply", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateCirculatingSupply(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateCirculatingSupply", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateCirculatingSupply(in0, in1)
 }
 
 func (p *UnSupport) StateCompute(in0 context.Context, in1 abi.ChainEpoch, in2 []*types.Message, in3 types.TipSetKey) (out0 *api1.ComputeStateOutput, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateCompute", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateCompute", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateCompute(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateCompute", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateCompute(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateDealProviderCollateralBounds(in0 context.Context, in1 abi.PaddedPieceSize, in2 bool, in3 types.TipSetKey) (out0 api1.DealCollateralBounds, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateDealProviderCollateralBounds", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateDealProviderCollateralBounds", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateDealProviderCollateralBounds(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateDealProviderCollateralBounds", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateDealProviderCollateralBounds(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateDecodeParams(in0 context.Context, in1 address.Address, in2 abi.MethodNum, in3 []uint8, in4 types.TipSetKey) (out0 interface{}, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateDecodeParams", []interface{}{in1, in2, in3, in4}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateDecodeParams", in1, in2, in3, in4)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateDecodeParams(in0, in1, in2, in3, in4)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateDecodeParams", in1, in2, in3, in4)
 	if err != nil {
 		return
 	}
+
 	return cli.StateDecodeParams(in0, in1, in2, in3, in4)
 }
 
 func (p *UnSupport) StateGetActor(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types.Actor, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateGetActor", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateGetActor", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateGetActor(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateGetActor", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateGetActor(in0, in1, in2)
 }
 
 func (p *UnSupport) StateGetReceipt(in0 context.Context, in1 cid.Cid, in2 types.TipSetKey) (out0 *types.MessageReceipt, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateGetReceipt", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateGetReceipt", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateGetReceipt(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateGetReceipt", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateGetReceipt(in0, in1, in2)
 }
 
 func (p *UnSupport) StateListActors(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateListActors", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateListActors", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateListActors(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateListActors", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateListActors(in0, in1)
 }
 
 func (p *UnSupport) StateListMessages(in0 context.Context, in1 *api1.MessageMatch, in2 types.TipSetKey, in3 abi.ChainEpoch) (out0 []cid.Cid, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateListMessages", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateListMessages", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateListMessages(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateListMessages", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateListMessages(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateListMiners(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateListMiners", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateListMiners", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateListMiners(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateListMiners", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateListMiners(in0, in1)
 }
 
 func (p *UnSupport) StateLookupID(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateLookupID", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateLookupID", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateLookupID(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateLookupID", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateLookupID(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMarketBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MarketBalance, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMarketBalance", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMarketBalance", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMarketBalance(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMarketBalance", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMarketBalance(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMarketDeals(in0 context.Context, in1 types.TipSetKey) (out0 map[string]api1.MarketDeal, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMarketDeals", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMarketDeals", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMarketDeals(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMarketDeals", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMarketDeals(in0, in1)
 }
 
 func (p *UnSupport) StateMarketParticipants(in0 context.Context, in1 types.TipSetKey) (out0 map[string]api1.MarketBalance, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMarketParticipants", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMarketParticipants", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMarketParticipants(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMarketParticipants", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMarketParticipants(in0, in1)
 }
 
 func (p *UnSupport) StateMarketStorageDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *api1.MarketDeal, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMarketStorageDeal", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMarketStorageDeal", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMarketStorageDeal(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMarketStorageDeal", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMarketStorageDeal(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerActiveSectors(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerActiveSectors", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerActiveSectors", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerActiveSectors(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerActiveSectors", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerActiveSectors(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerAvailableBalance", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerAvailableBalance", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerAvailableBalance(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerAvailableBalance", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerAvailableBalance(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerDeadlines(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []api1.Deadline, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerDeadlines", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerDeadlines", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerDeadlines(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerDeadlines", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerDeadlines(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerFaults(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerFaults", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerFaults", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerFaults(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerFaults", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerFaults(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerInfo(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 miner.MinerInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerInfo", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerInfo", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerInfo(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerInfo", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerInfo(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerInitialPledgeCollateral(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerInitialPledgeCollateral", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerInitialPledgeCollateral", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerInitialPledgeCollateral(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerInitialPledgeCollateral", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerInitialPledgeCollateral(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateMinerPartitions(in0 context.Context, in1 address.Address, in2 uint64, in3 types.TipSetKey) (out0 []api1.Partition, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerPartitions", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerPartitions", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerPartitions(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerPartitions", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerPartitions(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateMinerPower(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.MinerPower, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerPower", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerPower", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerPower(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerPower", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerPower(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerPreCommitDepositForPower(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerPreCommitDepositForPower", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerPreCommitDepositForPower", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerPreCommitDepositForPower(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerPreCommitDepositForPower", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerPreCommitDepositForPower(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateMinerProvingDeadline(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *dline.Info, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerProvingDeadline", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerProvingDeadline", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerProvingDeadline(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerProvingDeadline", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerProvingDeadline(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerRecoveries(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerRecoveries", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerRecoveries", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerRecoveries(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerRecoveries", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerRecoveries(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerSectorAllocated(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 bool, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerSectorAllocated", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerSectorAllocated", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerSectorAllocated(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerSectorAllocated", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerSectorAllocated(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateMinerSectorCount(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MinerSectors, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerSectorCount", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerSectorCount", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerSectorCount(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerSectorCount", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerSectorCount(in0, in1, in2)
 }
 
 func (p *UnSupport) StateMinerSectors(in0 context.Context, in1 address.Address, in2 *bitfield.BitField, in3 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateMinerSectors", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateMinerSectors", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateMinerSectors(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateMinerSectors", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateMinerSectors(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateNetworkName(in0 context.Context) (out0 dtypes.NetworkName, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateNetworkName", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateNetworkName")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateNetworkName(in0)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateNetworkName")
 	if err != nil {
 		return
 	}
+
 	return cli.StateNetworkName(in0)
 }
 
 func (p *UnSupport) StateNetworkVersion(in0 context.Context, in1 types.TipSetKey) (out0 network1.Version, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateNetworkVersion", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateNetworkVersion", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateNetworkVersion(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateNetworkVersion", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateNetworkVersion(in0, in1)
 }
 
 func (p *UnSupport) StateReadState(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.ActorState, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateReadState", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateReadState", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateReadState(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateReadState", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateReadState(in0, in1, in2)
 }
 
 func (p *UnSupport) StateReplay(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid) (out0 *api1.InvocResult, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateReplay", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateReplay", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateReplay(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateReplay", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateReplay(in0, in1, in2)
 }
 
 func (p *UnSupport) StateSearchMsg(in0 context.Context, in1 cid.Cid) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSearchMsg", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSearchMsg", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSearchMsg(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateSearchMsg", in1)
 	if err != nil {
 		return
 	}
+
 	return cli.StateSearchMsg(in0, in1)
 }
 
 func (p *UnSupport) StateSearchMsgLimited(in0 context.Context, in1 cid.Cid, in2 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSearchMsgLimited", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSearchMsgLimited", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSearchMsgLimited(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateSearchMsgLimited", in1, in2)
 	if err != nil {
 		return
 	}
+
 	return cli.StateSearchMsgLimited(in0, in1, in2)
 }
 
 func (p *UnSupport) StateSectorExpiration(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorExpiration, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSectorExpiration", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSectorExpiration", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSectorExpiration(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateSectorExpiration", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateSectorExpiration(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateSectorGetInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSectorGetInfo", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSectorGetInfo", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSectorGetInfo(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateSectorGetInfo", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateSectorGetInfo(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateSectorPartition(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorLocation, err error) {
-	cli, err := p.Select()
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSectorPartition", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSectorPartition", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSectorPartition(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "StateSectorPartition", in1, in2, in3)
 	if err != nil {
 		return
 	}
+
 	return cli.StateSectorPartition(in0, in1, in2, in3)
 }
 
 func (p *UnSupport) StateSectorPreCommitInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 miner.SectorPreCommitOnChainInfo, err error) {
-	cli, err := p.Select()
-	if err != nil {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateSectorPreCommitInfo", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateSectorPreCommitInfo", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateSectorPreCommitInfo(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.StateSectorPreCommitInfo(in0, in1, in2, in3)
-}
 
-func (p *UnSupport) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.TipSetKey) (out0 api1.CirculatingSupply, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateSectorPreCommitInfo", in1, in2, in3)
 	if err != nil {
 		return
 	}
-	return cli.StateVMCirculatingSupplyInternal(in0, in1)
+
+	return cli.StateSectorPreCommitInfo(in0, in1, in2, in3)
 }
 
-func (p *UnSupport) StateVerifiedClientStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.TipSetKey) (out0 api1.CirculatingSupply, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateVMCirculatingSupplyInternal", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateVMCirculatingSupplyInternal", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateVMCirculatingSupplyInternal(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.StateVerifiedClientStatus(in0, in1, in2)
-}
 
-func (p *UnSupport) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateVMCirculatingSupplyInternal", in1)
 	if err != nil {
 		return
 	}
-	return cli.StateVerifiedRegistryRootKey(in0, in1)
+
+	return cli.StateVMCirculatingSupplyInternal(in0, in1)
 }
 
-func (p *UnSupport) StateVerifierStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateVerifiedClientStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateVerifiedClientStatus", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateVerifiedClientStatus", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateVerifiedClientStatus(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.StateVerifierStatus(in0, in1, in2)
-}
 
-func (p *UnSupport) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateVerifiedClientStatus", in1, in2)
 	if err != nil {
 		return
 	}
-	return cli.StateWaitMsg(in0, in1, in2)
+
+	return cli.StateVerifiedClientStatus(in0, in1, in2)
 }
 
-func (p *UnSupport) StateWaitMsgLimited(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipSetKey) (out0 address.Address, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateVerifiedRegistryRootKey", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateVerifiedRegistryRootKey", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateVerifiedRegistryRootKey(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.StateWaitMsgLimited(in0, in1, in2, in3)
-}
 
-func (p *UnSupport) SyncCheckBad(in0 context.Context, in1 cid.Cid) (out0 string, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateVerifiedRegistryRootKey", in1)
 	if err != nil {
 		return
 	}
-	return cli.SyncCheckBad(in0, in1)
+
+	return cli.StateVerifiedRegistryRootKey(in0, in1)
 }
 
-func (p *UnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateVerifierStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateVerifierStatus", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateVerifierStatus", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateVerifierStatus(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.SyncCheckpoint(in0, in1)
-}
 
-func (p *UnSupport) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.BlockHeader, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateVerifierStatus", in1, in2)
 	if err != nil {
 		return
 	}
-	return cli.SyncIncomingBlocks(in0)
+
+	return cli.StateVerifierStatus(in0, in1, in2)
 }
 
-func (p *UnSupport) SyncMarkBad(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64) (out0 *api1.MsgLookup, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateWaitMsg", []interface{}{in1, in2}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateWaitMsg", in1, in2)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateWaitMsg(in0, in1, in2)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.SyncMarkBad(in0, in1)
-}
 
-func (p *UnSupport) SyncState(in0 context.Context) (out0 *api1.SyncState, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateWaitMsg", in1, in2)
 	if err != nil {
 		return
 	}
-	return cli.SyncState(in0)
+
+	return cli.StateWaitMsg(in0, in1, in2)
 }
 
-func (p *UnSupport) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) StateWaitMsgLimited(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "StateWaitMsgLimited", []interface{}{in1, in2, in3}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "StateWaitMsgLimited", in1, in2, in3)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.StateWaitMsgLimited(in0, in1, in2, in3)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.SyncSubmitBlock(in0, in1)
-}
 
-func (p *UnSupport) SyncUnmarkAllBad(in0 context.Context) (err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "StateWaitMsgLimited", in1, in2, in3)
 	if err != nil {
 		return
 	}
-	return cli.SyncUnmarkAllBad(in0)
+
+	return cli.StateWaitMsgLimited(in0, in1, in2, in3)
 }
 
-func (p *UnSupport) SyncUnmarkBad(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncCheckBad(in0 context.Context, in1 cid.Cid) (out0 string, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncCheckBad", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncCheckBad", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.SyncCheckBad(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.SyncUnmarkBad(in0, in1)
-}
 
-func (p *UnSupport) SyncValidateTipset(in0 context.Context, in1 types.TipSetKey) (out0 bool, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncCheckBad", in1)
 	if err != nil {
 		return
 	}
-	return cli.SyncValidateTipset(in0, in1)
+
+	return cli.SyncCheckBad(in0, in1)
 }
 
-func (p *UnSupport) Version(in0 context.Context) (out0 api1.APIVersion, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncCheckpoint", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncCheckpoint", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.SyncCheckpoint(in0, in1)
+		})
 		return
 	}
-	return cli.Version(in0)
-}
 
-func (p *UnSupport) WalletBalance(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncCheckpoint", in1)
 	if err != nil {
 		return
 	}
-	return cli.WalletBalance(in0, in1)
+
+	return cli.SyncCheckpoint(in0, in1)
 }
 
-func (p *UnSupport) WalletDefaultAddress(in0 context.Context) (out0 address.Address, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.BlockHeader, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncIncomingBlocks", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncIncomingBlocks")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.SyncIncomingBlocks(in0)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.WalletDefaultAddress(in0)
-}
 
-func (p *UnSupport) WalletDelete(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncIncomingBlocks")
 	if err != nil {
 		return
 	}
-	return cli.WalletDelete(in0, in1)
+
+	return cli.SyncIncomingBlocks(in0)
 }
 
-func (p *UnSupport) WalletExport(in0 context.Context, in1 address.Address) (out0 *types.KeyInfo, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncMarkBad(in0 context.Context, in1 cid.Cid) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncMarkBad", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncMarkBad", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.SyncMarkBad(in0, in1)
+		})
 		return
 	}
-	return cli.WalletExport(in0, in1)
-}
 
-func (p *UnSupport) WalletHas(in0 context.Context, in1 address.Address) (out0 bool, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncMarkBad", in1)
 	if err != nil {
 		return
 	}
-	return cli.WalletHas(in0, in1)
+
+	return cli.SyncMarkBad(in0, in1)
 }
 
-func (p *UnSupport) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0 address.Address, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncUnmarkAllBad(in0 context.Context) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncUnmarkAllBad", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncUnmarkAllBad")
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.SyncUnmarkAllBad(in0)
+		})
 		return
 	}
-	return cli.WalletImport(in0, in1)
-}
 
-func (p *UnSupport) WalletList(in0 context.Context) (out0 []address.Address, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncUnmarkAllBad")
 	if err != nil {
 		return
 	}
-	return cli.WalletList(in0)
+
+	return cli.SyncUnmarkAllBad(in0)
 }
 
-func (p *UnSupport) WalletNew(in0 context.Context, in1 types.KeyType) (out0 address.Address, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncUnmarkBad(in0 context.Context, in1 cid.Cid) (err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncUnmarkBad", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncUnmarkBad", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			return func() {}, cli.SyncUnmarkBad(in0, in1)
+		})
 		return
 	}
-	return cli.WalletNew(in0, in1)
-}
 
-func (p *UnSupport) WalletSetDefault(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncUnmarkBad", in1)
 	if err != nil {
 		return
 	}
-	return cli.WalletSetDefault(in0, in1)
+
+	return cli.SyncUnmarkBad(in0, in1)
 }
 
-func (p *UnSupport) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8) (out0 *crypto.Signature, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) SyncValidateTipset(in0 context.Context, in1 types.TipSetKey) (out0 bool, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "SyncValidateTipset", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "SyncValidateTipset", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.SyncValidateTipset(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.WalletSign(in0, in1, in2)
-}
 
-func (p *UnSupport) WalletSignMessage(in0 context.Context, in1 address.Address, in2 *types.Message) (out0 *types.SignedMessage, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "SyncValidateTipset", in1)
 	if err != nil {
 		return
 	}
-	return cli.WalletSignMessage(in0, in1, in2)
+
+	return cli.SyncValidateTipset(in0, in1)
 }
 
-func (p *UnSupport) WalletValidateAddress(in0 context.Context, in1 string) (out0 address.Address, err error) {
-	cli, err := p.Select()
-	if err != nil {
+func (p *UnSupport) Version(in0 context.Context) (out0 api1.APIVersion, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "Version", []interface{}{}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "Version")
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.Version(in0)
+			return func() { out0 = ret0 }, err
+		})
 		return
 	}
-	return cli.WalletValidateAddress(in0, in1)
-}
 
-func (p *UnSupport) WalletVerify(in0 context.Context, in1 address.Address, in2 []uint8, in3 *crypto.Signature) (out0 bool, err error) {
-	cli, err := p.Select()
+	cli, err := p.Select(in0, "Version")
 	if err != nil {
 		return
 	}
-	return cli.WalletVerify(in0, in1, in2, in3)
+
+	return cli.Version(in0)
 }
@@ -0,0 +1,1764 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-data-transfer"
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/filecoin-project/go-multistore"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/dline"
+	network1 "github.com/filecoin-project/go-state-types/network"
+	api1 "github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/miner"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/markets/loggers"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	miner1 "github.com/filecoin-project/specs-actors/actors/builtin/miner"
+	"github.com/filecoin-project/specs-actors/actors/builtin/paych"
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"sync"
+)
+
+var _ UnSupportAPI = (*MockUnSupport)(nil)
+var _ api.UnSupport = (*MockUnSupport)(nil)
+
+// MockUnSupportCall is a call recorded by MockUnSupport, Args are the params except the context
+type MockUnSupportCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// MockUnSupport is a mock implementation of UnSupportAPI, methods without a fake func return zero values and an error
+type MockUnSupport struct {
+	AuthNewFunc                                   func(context.Context, []auth.Permission) ([]uint8, error)
+	AuthVerifyFunc                                func(context.Context, string) ([]auth.Permission, error)
+	ChainDeleteObjFunc                            func(context.Context, cid.Cid) error
+	ChainExportFunc                               func(context.Context, abi.ChainEpoch, bool, types.TipSetKey) (<-chan []uint8, error)
+	ChainGetNodeFunc                              func(context.Context, string) (*api1.IpldObject, error)
+	ChainGetPathFunc                              func(context.Context, types.TipSetKey, types.TipSetKey) ([]*api1.HeadChange, error)
+	ChainHasObjFunc                               func(context.Context, cid.Cid) (bool, error)
+	ChainReadObjFunc                              func(context.Context, cid.Cid) ([]uint8, error)
+	ChainSetHeadFunc                              func(context.Context, types.TipSetKey) error
+	ChainStatObjFunc                              func(context.Context, cid.Cid, cid.Cid) (api1.ObjStat, error)
+	ClientCalcCommPFunc                           func(context.Context, string) (*api1.CommPRet, error)
+	ClientCancelDataTransferFunc                  func(context.Context, datatransfer.TransferID, peer.ID, bool) error
+	ClientDataTransferUpdatesFunc                 func(context.Context) (<-chan api1.DataTransferChannel, error)
+	ClientDealPieceCIDFunc                        func(context.Context, cid.Cid) (api1.DataCIDSize, error)
+	ClientDealSizeFunc                            func(context.Context, cid.Cid) (api1.DataSize, error)
+	ClientFindDataFunc                            func(context.Context, cid.Cid, *cid.Cid) ([]api1.QueryOffer, error)
+	ClientGenCarFunc                              func(context.Context, api1.FileRef, string) error
+	ClientGetDealInfoFunc                         func(context.Context, cid.Cid) (*api1.DealInfo, error)
+	ClientGetDealStatusFunc                       func(context.Context, uint64) (string, error)
+	ClientGetDealUpdatesFunc                      func(context.Context) (<-chan api1.DealInfo, error)
+	ClientHasLocalFunc                            func(context.Context, cid.Cid) (bool, error)
+	ClientImportFunc                              func(context.Context, api1.FileRef) (*api1.ImportRes, error)
+	ClientListDataTransfersFunc                   func(context.Context) ([]api1.DataTransferChannel, error)
+	ClientListDealsFunc                           func(context.Context) ([]api1.DealInfo, error)
+	ClientListImportsFunc                         func(context.Context) ([]api1.Import, error)
+	ClientMinerQueryOfferFunc                     func(context.Context, address.Address, cid.Cid, *cid.Cid) (api1.QueryOffer, error)
+	ClientQueryAskFunc                            func(context.Context, peer.ID, address.Address) (*storagemarket.StorageAsk, error)
+	ClientRemoveImportFunc                        func(context.Context, multistore.StoreID) error
+	ClientRestartDataTransferFunc                 func(context.Context, datatransfer.TransferID, peer.ID, bool) error
+	ClientRetrieveFunc                            func(context.Context, api1.RetrievalOrder, *api1.FileRef) error
+	ClientRetrieveTryRestartInsufficientFundsFunc func(context.Context, address.Address) error
+	ClientRetrieveWithEventsFunc                  func(context.Context, api1.RetrievalOrder, *api1.FileRef) (<-chan marketevents.RetrievalEvent, error)
+	ClientStartDealFunc                           func(context.Context, *api1.StartDealParams) (*cid.Cid, error)
+	ClosingFunc                                   func(context.Context) (<-chan struct{}, error)
+	CreateBackupFunc                              func(context.Context, string) error
+	IDFunc                                        func(context.Context) (peer.ID, error)
+	LogListFunc                                   func(context.Context) ([]string, error)
+	LogSetLevelFunc                               func(context.Context, string, string) error
+	MarketAddBalanceFunc                          func(context.Context, address.Address, address.Address, big.Int) (cid.Cid, error)
+	MarketGetReservedFunc                         func(context.Context, address.Address) (big.Int, error)
+	MarketReleaseFundsFunc                        func(context.Context, address.Address, big.Int) error
+	MarketReserveFundsFunc                        func(context.Context, address.Address, address.Address, big.Int) (cid.Cid, error)
+	MarketWithdrawFunc                            func(context.Context, address.Address, address.Address, big.Int) (cid.Cid, error)
+	MpoolClearFunc                                func(context.Context, bool) error
+	MpoolGetConfigFunc                            func(context.Context) (*types.MpoolConfig, error)
+	MpoolSelectFunc                               func(context.Context, types.TipSetKey, float64) ([]*types.SignedMessage, error)
+	MpoolSetConfigFunc                            func(context.Context, *types.MpoolConfig) error
+	MsigAddApproveFunc                            func(context.Context, address.Address, address.Address, uint64, address.Address, address.Address, bool) (cid.Cid, error)
+	MsigAddCancelFunc                             func(context.Context, address.Address, address.Address, uint64, address.Address, bool) (cid.Cid, error)
+	MsigAddProposeFunc                            func(context.Context, address.Address, address.Address, address.Address, bool) (cid.Cid, error)
+	MsigApproveFunc                               func(context.Context, address.Address, uint64, address.Address) (cid.Cid, error)
+	MsigApproveTxnHashFunc                        func(context.Context, address.Address, uint64, address.Address, address.Address, big.Int, address.Address, uint64, []uint8) (cid.Cid, error)
+	MsigCancelFunc                                func(context.Context, address.Address, uint64, address.Address, big.Int, address.Address, uint64, []uint8) (cid.Cid, error)
+	MsigCreateFunc                                func(context.Context, uint64, []address.Address, abi.ChainEpoch, big.Int, address.Address, big.Int) (cid.Cid, error)
+	MsigGetAvailableBalanceFunc                   func(context.Context, address.Address, types.TipSetKey) (big.Int, error)
+	MsigGetPendingFunc                            func(context.Context, address.Address, types.TipSetKey) ([]*api1.MsigTransaction, error)
+	MsigGetVestedFunc                             func(context.Context, address.Address, types.TipSetKey, types.TipSetKey) (big.Int, error)
+	MsigGetVestingScheduleFunc                    func(context.Context, address.Address, types.TipSetKey) (api1.MsigVesting, error)
+	MsigProposeFunc                               func(context.Context, address.Address, address.Address, big.Int, address.Address, uint64, []uint8) (cid.Cid, error)
+	MsigRemoveSignerFunc                          func(context.Context, address.Address, address.Address, address.Address, bool) (cid.Cid, error)
+	MsigSwapApproveFunc                           func(context.Context, address.Address, address.Address, uint64, address.Address, address.Address, address.Address) (cid.Cid, error)
+	MsigSwapCancelFunc                            func(context.Context, address.Address, address.Address, uint64, address.Address, address.Address) (cid.Cid, error)
+	MsigSwapProposeFunc                           func(context.Context, address.Address, address.Address, address.Address, address.Address) (cid.Cid, error)
+	NetAddrsListenFunc                            func(context.Context) (peer.AddrInfo, error)
+	NetAgentVersionFunc                           func(context.Context, peer.ID) (string, error)
+	NetAutoNatStatusFunc                          func(context.Context) (api1.NatInfo, error)
+	NetBandwidthStatsFunc                         func(context.Context) (metrics.Stats, error)
+	NetBandwidthStatsByPeerFunc                   func(context.Context) (map[string]metrics.Stats, error)
+	NetBandwidthStatsByProtocolFunc               func(context.Context) (map[protocol.ID]metrics.Stats, error)
+	NetBlockAddFunc                               func(context.Context, api1.NetBlockList) error
+	NetBlockListFunc                              func(context.Context) (api1.NetBlockList, error)
+	NetBlockRemoveFunc                            func(context.Context, api1.NetBlockList) error
+	NetConnectFunc                                func(context.Context, peer.AddrInfo) error
+	NetConnectednessFunc                          func(context.Context, peer.ID) (network.Connectedness, error)
+	NetDisconnectFunc                             func(context.Context, peer.ID) error
+	NetFindPeerFunc                               func(context.Context, peer.ID) (peer.AddrInfo, error)
+	NetPeerInfoFunc                               func(context.Context, peer.ID) (*api1.ExtendedPeerInfo, error)
+	NetPeersFunc                                  func(context.Context) ([]peer.AddrInfo, error)
+	NetPubsubScoresFunc                           func(context.Context) ([]api1.PubsubScore, error)
+	PaychAllocateLaneFunc                         func(context.Context, address.Address) (uint64, error)
+	PaychAvailableFundsFunc                       func(context.Context, address.Address) (*api1.ChannelAvailableFunds, error)
+	PaychAvailableFundsByFromToFunc               func(context.Context, address.Address, address.Address) (*api1.ChannelAvailableFunds, error)
+	PaychCollectFunc                              func(context.Context, address.Address) (cid.Cid, error)
+	PaychGetFunc                                  func(context.Context, address.Address, address.Address, big.Int) (*api1.ChannelInfo, error)
+	PaychGetWaitReadyFunc                         func(context.Context, cid.Cid) (address.Address, error)
+	PaychListFunc                                 func(context.Context) ([]address.Address, error)
+	PaychNewPaymentFunc                           func(context.Context, address.Address, address.Address, []api1.VoucherSpec) (*api1.PaymentInfo, error)
+	PaychSettleFunc                               func(context.Context, address.Address) (cid.Cid, error)
+	PaychStatusFunc                               func(context.Context, address.Address) (*api1.PaychStatus, error)
+	PaychVoucherAddFunc                           func(context.Context, address.Address, *paych.SignedVoucher, []uint8, big.Int) (big.Int, error)
+	PaychVoucherCheckSpendableFunc                func(context.Context, address.Address, *paych.SignedVoucher, []uint8, []uint8) (bool, error)
+	PaychVoucherCheckValidFunc                    func(context.Context, address.Address, *paych.SignedVoucher) error
+	PaychVoucherCreateFunc                        func(context.Context, address.Address, big.Int, uint64) (*api1.VoucherCreateResult, error)
+	PaychVoucherListFunc                          func(context.Context, address.Address) ([]*paych.SignedVoucher, error)
+	PaychVoucherSubmitFunc                        func(context.Context, address.Address, *paych.SignedVoucher, []uint8, []uint8) (cid.Cid, error)
+	SessionFunc                                   func(context.Context) (uuid.UUID, error)
+	ShutdownFunc                                  func(context.Context) error
+	StateAccountKeyFunc                           func(context.Context, address.Address, types.TipSetKey) (address.Address, error)
+	StateAllMinerFaultsFunc                       func(context.Context, abi.ChainEpoch, types.TipSetKey) ([]*api1.Fault, error)
+	StateCallFunc                                 func(context.Context, *types.Message, types.TipSetKey) (*api1.InvocResult, error)
+	StateChangedActorsFunc                        func(context.Context, cid.Cid, cid.Cid) (map[string]types.Actor, error)
+	StateCirculatingSupplyFunc                    func(context.Context, types.TipSetKey) (big.Int, error)
+	StateComputeFunc                              func(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*api1.ComputeStateOutput, error)
+	StateDealProviderCollateralBoundsFunc         func(context.Context, abi.PaddedPieceSize, bool, types.TipSetKey) (api1.DealCollateralBounds, error)
+	StateDecodeParamsFunc                         func(context.Context, address.Address, abi.MethodNum, []uint8, types.TipSetKey) (interface{}, error)
+	StateGetActorFunc                             func(context.Context, address.Address, types.TipSetKey) (*types.Actor, error)
+	StateGetReceiptFunc                           func(context.Context, cid.Cid, types.TipSetKey) (*types.MessageReceipt, error)
+	StateListActorsFunc                           func(context.Context, types.TipSetKey) ([]address.Address, error)
+	StateListMessagesFunc                         func(context.Context, *api1.MessageMatch, types.TipSetKey, abi.ChainEpoch) ([]cid.Cid, error)
+	StateListMinersFunc                           func(context.Context, types.TipSetKey) ([]address.Address, error)
+	StateLookupIDFunc                             func(context.Context, address.Address, types.TipSetKey) (address.Address, error)
+	StateMarketBalanceFunc                        func(context.Context, address.Address, types.TipSetKey) (api1.MarketBalance, error)
+	StateMarketDealsFunc                          func(context.Context, types.TipSetKey) (map[string]api1.MarketDeal, error)
+	StateMarketParticipantsFunc                   func(context.Context, types.TipSetKey) (map[string]api1.MarketBalance, error)
+	StateMarketStorageDealFunc                    func(context.Context, abi.DealID, types.TipSetKey) (*api1.MarketDeal, error)
+	StateMinerActiveSectorsFunc                   func(context.Context, address.Address, types.TipSetKey) ([]*miner.SectorOnChainInfo, error)
+	StateMinerAvailableBalanceFunc                func(context.Context, address.Address, types.TipSetKey) (big.Int, error)
+	StateMinerDeadlinesFunc                       func(context.Context, address.Address, types.TipSetKey) ([]api1.Deadline, error)
+	StateMinerFaultsFunc                          func(context.Context, address.Address, types.TipSetKey) (bitfield.BitField, error)
+	StateMinerInfoFunc                            func(context.Context, address.Address, types.TipSetKey) (miner.MinerInfo, error)
+	StateMinerInitialPledgeCollateralFunc         func(context.Context, address.Address, miner1.SectorPreCommitInfo, types.TipSetKey) (big.Int, error)
+	StateMinerPartitionsFunc                      func(context.Context, address.Address, uint64, types.TipSetKey) ([]api1.Partition, error)
+	StateMinerPowerFunc                           func(context.Context, address.Address, types.TipSetKey) (*api1.MinerPower, error)
+	StateMinerPreCommitDepositForPowerFunc        func(context.Context, address.Address, miner1.SectorPreCommitInfo, types.TipSetKey) (big.Int, error)
+	StateMinerProvingDeadlineFunc                 func(context.Context, address.Address, types.TipSetKey) (*dline.Info, error)
+	StateMinerRecoveriesFunc                      func(context.Context, address.Address, types.TipSetKey) (bitfield.BitField, error)
+	StateMinerSectorAllocatedFunc                 func(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (bool, error)
+	StateMinerSectorCountFunc                     func(context.Context, address.Address, types.TipSetKey) (api1.MinerSectors, error)
+	StateMinerSectorsFunc                         func(context.Context, address.Address, *bitfield.BitField, types.TipSetKey) ([]*miner.SectorOnChainInfo, error)
+	StateNetworkNameFunc                          func(context.Context) (dtypes.NetworkName, error)
+	StateNetworkVersionFunc                       func(context.Context, types.TipSetKey) (network1.Version, error)
+	StateReadStateFunc                            func(context.Context, address.Address, types.TipSetKey) (*api1.ActorState, error)
+	StateReplayFunc                               func(context.Context, types.TipSetKey, cid.Cid) (*api1.InvocResult, error)
+	StateSearchMsgFunc                            func(context.Context, cid.Cid) (*api1.MsgLookup, error)
+	StateSearchMsgLimitedFunc                     func(context.Context, cid.Cid, abi.ChainEpoch) (*api1.MsgLookup, error)
+	StateSectorExpirationFunc                     func(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*miner.SectorExpiration, error)
+	StateSectorGetInfoFunc                        func(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*miner.SectorOnChainInfo, error)
+	StateSectorPartitionFunc                      func(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*miner.SectorLocation, error)
+	StateSectorPreCommitInfoFunc                  func(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (miner.SectorPreCommitOnChainInfo, error)
+	StateVMCirculatingSupplyInternalFunc          func(context.Context, types.TipSetKey) (api1.CirculatingSupply, error)
+	StateVerifiedClientStatusFunc                 func(context.Context, address.Address, types.TipSetKey) (*big.Int, error)
+	StateVerifiedRegistryRootKeyFunc              func(context.Context, types.TipSetKey) (address.Address, error)
+	StateVerifierStatusFunc                       func(context.Context, address.Address, types.TipSetKey) (*big.Int, error)
+	StateWaitMsgFunc                              func(context.Context, cid.Cid, uint64) (*api1.MsgLookup, error)
+	StateWaitMsgLimitedFunc                       func(context.Context, cid.Cid, uint64, abi.ChainEpoch) (*api1.MsgLookup, error)
+	SyncCheckBadFunc                              func(context.Context, cid.Cid) (string, error)
+	SyncCheckpointFunc                            func(context.Context, types.TipSetKey) error
+	SyncIncomingBlocksFunc                        func(context.Context) (<-chan *types.BlockHeader, error)
+	SyncMarkBadFunc                               func(context.Context, cid.Cid) error
+	SyncUnmarkAllBadFunc                          func(context.Context) error
+	SyncUnmarkBadFunc                             func(context.Context, cid.Cid) error
+	SyncValidateTipsetFunc                        func(context.Context, types.TipSetKey) (bool, error)
+	VersionFunc                                   func(context.Context) (api1.APIVersion, error)
+
+	calls struct {
+		sync.Mutex
+		records []MockUnSupportCall
+	}
+}
+
+func (m *MockUnSupport) record(method string, args ...interface{}) {
+	m.calls.Lock()
+	m.calls.records = append(m.calls.records, MockUnSupportCall{Method: method, Args: args})
+	m.calls.Unlock()
+}
+
+// Calls returns the recorded calls
+func (m *MockUnSupport) Calls() []MockUnSupportCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	calls := make([]MockUnSupportCall, len(m.calls.records))
+	copy(calls, m.calls.records)
+	return calls
+}
+
+// CallsOf returns the recorded calls of the given method
+func (m *MockUnSupport) CallsOf(method string) []MockUnSupportCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	var calls []MockUnSupportCall
+	for _, call := range m.calls.records {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// impl api.UnSupport
+func (m *MockUnSupport) AuthNew(in0 context.Context, in1 []auth.Permission) (out0 []uint8, err error) {
+	m.record("AuthNew", in1)
+	if m.AuthNewFunc == nil {
+		err = fmt.Errorf("MockUnSupport.AuthNew not mocked")
+		return
+	}
+
+	return m.AuthNewFunc(in0, in1)
+}
+
+func (m *MockUnSupport) AuthVerify(in0 context.Context, in1 string) (out0 []auth.Permission, err error) {
+	m.record("AuthVerify", in1)
+	if m.AuthVerifyFunc == nil {
+		err = fmt.Errorf("MockUnSupport.AuthVerify not mocked")
+		return
+	}
+
+	return m.AuthVerifyFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainDeleteObj(in0 context.Context, in1 cid.Cid) (err error) {
+	m.record("ChainDeleteObj", in1)
+	if m.ChainDeleteObjFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainDeleteObj not mocked")
+		return
+	}
+
+	return m.ChainDeleteObjFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainExport(in0 context.Context, in1 abi.ChainEpoch, in2 bool, in3 types.TipSetKey) (out0 <-chan []uint8, err error) {
+	m.record("ChainExport", in1, in2, in3)
+	if m.ChainExportFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainExport not mocked")
+		return
+	}
+
+	return m.ChainExportFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) ChainGetNode(in0 context.Context, in1 string) (out0 *api1.IpldObject, err error) {
+	m.record("ChainGetNode", in1)
+	if m.ChainGetNodeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainGetNode not mocked")
+		return
+	}
+
+	return m.ChainGetNodeFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainGetPath(in0 context.Context, in1 types.TipSetKey, in2 types.TipSetKey) (out0 []*api1.HeadChange, err error) {
+	m.record("ChainGetPath", in1, in2)
+	if m.ChainGetPathFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainGetPath not mocked")
+		return
+	}
+
+	return m.ChainGetPathFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ChainHasObj(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
+	m.record("ChainHasObj", in1)
+	if m.ChainHasObjFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainHasObj not mocked")
+		return
+	}
+
+	return m.ChainHasObjFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainReadObj(in0 context.Context, in1 cid.Cid) (out0 []uint8, err error) {
+	m.record("ChainReadObj", in1)
+	if m.ChainReadObjFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainReadObj not mocked")
+		return
+	}
+
+	return m.ChainReadObjFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainSetHead(in0 context.Context, in1 types.TipSetKey) (err error) {
+	m.record("ChainSetHead", in1)
+	if m.ChainSetHeadFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainSetHead not mocked")
+		return
+	}
+
+	return m.ChainSetHeadFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ChainStatObj(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 api1.ObjStat, err error) {
+	m.record("ChainStatObj", in1, in2)
+	if m.ChainStatObjFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ChainStatObj not mocked")
+		return
+	}
+
+	return m.ChainStatObjFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientCalcCommP(in0 context.Context, in1 string) (out0 *api1.CommPRet, err error) {
+	m.record("ClientCalcCommP", in1)
+	if m.ClientCalcCommPFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientCalcCommP not mocked")
+		return
+	}
+
+	return m.ClientCalcCommPFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientCancelDataTransfer(in0 context.Context, in1 datatransfer.TransferID, in2 peer.ID, in3 bool) (err error) {
+	m.record("ClientCancelDataTransfer", in1, in2, in3)
+	if m.ClientCancelDataTransferFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientCancelDataTransfer not mocked")
+		return
+	}
+
+	return m.ClientCancelDataTransferFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) ClientDataTransferUpdates(in0 context.Context) (out0 <-chan api1.DataTransferChannel, err error) {
+	m.record("ClientDataTransferUpdates")
+	if m.ClientDataTransferUpdatesFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientDataTransferUpdates not mocked")
+		return
+	}
+
+	return m.ClientDataTransferUpdatesFunc(in0)
+}
+
+func (m *MockUnSupport) ClientDealPieceCID(in0 context.Context, in1 cid.Cid) (out0 api1.DataCIDSize, err error) {
+	m.record("ClientDealPieceCID", in1)
+	if m.ClientDealPieceCIDFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientDealPieceCID not mocked")
+		return
+	}
+
+	return m.ClientDealPieceCIDFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientDealSize(in0 context.Context, in1 cid.Cid) (out0 api1.DataSize, err error) {
+	m.record("ClientDealSize", in1)
+	if m.ClientDealSizeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientDealSize not mocked")
+		return
+	}
+
+	return m.ClientDealSizeFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientFindData(in0 context.Context, in1 cid.Cid, in2 *cid.Cid) (out0 []api1.QueryOffer, err error) {
+	m.record("ClientFindData", in1, in2)
+	if m.ClientFindDataFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientFindData not mocked")
+		return
+	}
+
+	return m.ClientFindDataFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientGenCar(in0 context.Context, in1 api1.FileRef, in2 string) (err error) {
+	m.record("ClientGenCar", in1, in2)
+	if m.ClientGenCarFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientGenCar not mocked")
+		return
+	}
+
+	return m.ClientGenCarFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientGetDealInfo(in0 context.Context, in1 cid.Cid) (out0 *api1.DealInfo, err error) {
+	m.record("ClientGetDealInfo", in1)
+	if m.ClientGetDealInfoFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientGetDealInfo not mocked")
+		return
+	}
+
+	return m.ClientGetDealInfoFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientGetDealStatus(in0 context.Context, in1 uint64) (out0 string, err error) {
+	m.record("ClientGetDealStatus", in1)
+	if m.ClientGetDealStatusFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientGetDealStatus not mocked")
+		return
+	}
+
+	return m.ClientGetDealStatusFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientGetDealUpdates(in0 context.Context) (out0 <-chan api1.DealInfo, err error) {
+	m.record("ClientGetDealUpdates")
+	if m.ClientGetDealUpdatesFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientGetDealUpdates not mocked")
+		return
+	}
+
+	return m.ClientGetDealUpdatesFunc(in0)
+}
+
+func (m *MockUnSupport) ClientHasLocal(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
+	m.record("ClientHasLocal", in1)
+	if m.ClientHasLocalFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientHasLocal not mocked")
+		return
+	}
+
+	return m.ClientHasLocalFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientImport(in0 context.Context, in1 api1.FileRef) (out0 *api1.ImportRes, err error) {
+	m.record("ClientImport", in1)
+	if m.ClientImportFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientImport not mocked")
+		return
+	}
+
+	return m.ClientImportFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientListDataTransfers(in0 context.Context) (out0 []api1.DataTransferChannel, err error) {
+	m.record("ClientListDataTransfers")
+	if m.ClientListDataTransfersFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientListDataTransfers not mocked")
+		return
+	}
+
+	return m.ClientListDataTransfersFunc(in0)
+}
+
+func (m *MockUnSupport) ClientListDeals(in0 context.Context) (out0 []api1.DealInfo, err error) {
+	m.record("ClientListDeals")
+	if m.ClientListDealsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientListDeals not mocked")
+		return
+	}
+
+	return m.ClientListDealsFunc(in0)
+}
+
+func (m *MockUnSupport) ClientListImports(in0 context.Context) (out0 []api1.Import, err error) {
+	m.record("ClientListImports")
+	if m.ClientListImportsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientListImports not mocked")
+		return
+	}
+
+	return m.ClientListImportsFunc(in0)
+}
+
+func (m *MockUnSupport) ClientMinerQueryOffer(in0 context.Context, in1 address.Address, in2 cid.Cid, in3 *cid.Cid) (out0 api1.QueryOffer, err error) {
+	m.record("ClientMinerQueryOffer", in1, in2, in3)
+	if m.ClientMinerQueryOfferFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientMinerQueryOffer not mocked")
+		return
+	}
+
+	return m.ClientMinerQueryOfferFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) ClientQueryAsk(in0 context.Context, in1 peer.ID, in2 address.Address) (out0 *storagemarket.StorageAsk, err error) {
+	m.record("ClientQueryAsk", in1, in2)
+	if m.ClientQueryAskFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientQueryAsk not mocked")
+		return
+	}
+
+	return m.ClientQueryAskFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientRemoveImport(in0 context.Context, in1 multistore.StoreID) (err error) {
+	m.record("ClientRemoveImport", in1)
+	if m.ClientRemoveImportFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientRemoveImport not mocked")
+		return
+	}
+
+	return m.ClientRemoveImportFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientRestartDataTransfer(in0 context.Context, in1 datatransfer.TransferID, in2 peer.ID, in3 bool) (err error) {
+	m.record("ClientRestartDataTransfer", in1, in2, in3)
+	if m.ClientRestartDataTransferFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientRestartDataTransfer not mocked")
+		return
+	}
+
+	return m.ClientRestartDataTransferFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) ClientRetrieve(in0 context.Context, in1 api1.RetrievalOrder, in2 *api1.FileRef) (err error) {
+	m.record("ClientRetrieve", in1, in2)
+	if m.ClientRetrieveFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientRetrieve not mocked")
+		return
+	}
+
+	return m.ClientRetrieveFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientRetrieveTryRestartInsufficientFunds(in0 context.Context, in1 address.Address) (err error) {
+	m.record("ClientRetrieveTryRestartInsufficientFunds", in1)
+	if m.ClientRetrieveTryRestartInsufficientFundsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientRetrieveTryRestartInsufficientFunds not mocked")
+		return
+	}
+
+	return m.ClientRetrieveTryRestartInsufficientFundsFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ClientRetrieveWithEvents(in0 context.Context, in1 api1.RetrievalOrder, in2 *api1.FileRef) (out0 <-chan marketevents.RetrievalEvent, err error) {
+	m.record("ClientRetrieveWithEvents", in1, in2)
+	if m.ClientRetrieveWithEventsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientRetrieveWithEvents not mocked")
+		return
+	}
+
+	return m.ClientRetrieveWithEventsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) ClientStartDeal(in0 context.Context, in1 *api1.StartDealParams) (out0 *cid.Cid, err error) {
+	m.record("ClientStartDeal", in1)
+	if m.ClientStartDealFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ClientStartDeal not mocked")
+		return
+	}
+
+	return m.ClientStartDealFunc(in0, in1)
+}
+
+func (m *MockUnSupport) Closing(in0 context.Context) (out0 <-chan struct{}, err error) {
+	m.record("Closing")
+	if m.ClosingFunc == nil {
+		err = fmt.Errorf("MockUnSupport.Closing not mocked")
+		return
+	}
+
+	return m.ClosingFunc(in0)
+}
+
+func (m *MockUnSupport) CreateBackup(in0 context.Context, in1 string) (err error) {
+	m.record("CreateBackup", in1)
+	if m.CreateBackupFunc == nil {
+		err = fmt.Errorf("MockUnSupport.CreateBackup not mocked")
+		return
+	}
+
+	return m.CreateBackupFunc(in0, in1)
+}
+
+func (m *MockUnSupport) ID(in0 context.Context) (out0 peer.ID, err error) {
+	m.record("ID")
+	if m.IDFunc == nil {
+		err = fmt.Errorf("MockUnSupport.ID not mocked")
+		return
+	}
+
+	return m.IDFunc(in0)
+}
+
+func (m *MockUnSupport) LogList(in0 context.Context) (out0 []string, err error) {
+	m.record("LogList")
+	if m.LogListFunc == nil {
+		err = fmt.Errorf("MockUnSupport.LogList not mocked")
+		return
+	}
+
+	return m.LogListFunc(in0)
+}
+
+func (m *MockUnSupport) LogSetLevel(in0 context.Context, in1 string, in2 string) (err error) {
+	m.record("LogSetLevel", in1, in2)
+	if m.LogSetLevelFunc == nil {
+		err = fmt.Errorf("MockUnSupport.LogSetLevel not mocked")
+		return
+	}
+
+	return m.LogSetLevelFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MarketAddBalance(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
+	m.record("MarketAddBalance", in1, in2, in3)
+	if m.MarketAddBalanceFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MarketAddBalance not mocked")
+		return
+	}
+
+	return m.MarketAddBalanceFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) MarketGetReserved(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
+	m.record("MarketGetReserved", in1)
+	if m.MarketGetReservedFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MarketGetReserved not mocked")
+		return
+	}
+
+	return m.MarketGetReservedFunc(in0, in1)
+}
+
+func (m *MockUnSupport) MarketReleaseFunds(in0 context.Context, in1 address.Address, in2 big.Int) (err error) {
+	m.record("MarketReleaseFunds", in1, in2)
+	if m.MarketReleaseFundsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MarketReleaseFunds not mocked")
+		return
+	}
+
+	return m.MarketReleaseFundsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MarketReserveFunds(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
+	m.record("MarketReserveFunds", in1, in2, in3)
+	if m.MarketReserveFundsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MarketReserveFunds not mocked")
+		return
+	}
+
+	return m.MarketReserveFundsFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) MarketWithdraw(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
+	m.record("MarketWithdraw", in1, in2, in3)
+	if m.MarketWithdrawFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MarketWithdraw not mocked")
+		return
+	}
+
+	return m.MarketWithdrawFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
+	m.record("MpoolClear", in1)
+	if m.MpoolClearFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MpoolClear not mocked")
+		return
+	}
+
+	return m.MpoolClearFunc(in0, in1)
+}
+
+func (m *MockUnSupport) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, err error) {
+	m.record("MpoolGetConfig")
+	if m.MpoolGetConfigFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MpoolGetConfig not mocked")
+		return
+	}
+
+	return m.MpoolGetConfigFunc(in0)
+}
+
+func (m *MockUnSupport) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float64) (out0 []*types.SignedMessage, err error) {
+	m.record("MpoolSelect", in1, in2)
+	if m.MpoolSelectFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MpoolSelect not mocked")
+		return
+	}
+
+	return m.MpoolSelectFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig) (err error) {
+	m.record("MpoolSetConfig", in1)
+	if m.MpoolSetConfigFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MpoolSetConfig not mocked")
+		return
+	}
+
+	return m.MpoolSetConfigFunc(in0, in1)
+}
+
+func (m *MockUnSupport) MsigAddApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 bool) (out0 cid.Cid, err error) {
+	m.record("MsigAddApprove", in1, in2, in3, in4, in5, in6)
+	if m.MsigAddApproveFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigAddApprove not mocked")
+		return
+	}
+
+	return m.MsigAddApproveFunc(in0, in1, in2, in3, in4, in5, in6)
+}
+
+func (m *MockUnSupport) MsigAddCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 bool) (out0 cid.Cid, err error) {
+	m.record("MsigAddCancel", in1, in2, in3, in4, in5)
+	if m.MsigAddCancelFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigAddCancel not mocked")
+		return
+	}
+
+	return m.MsigAddCancelFunc(in0, in1, in2, in3, in4, in5)
+}
+
+func (m *MockUnSupport) MsigAddPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 cid.Cid, err error) {
+	m.record("MsigAddPropose", in1, in2, in3, in4)
+	if m.MsigAddProposeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigAddPropose not mocked")
+		return
+	}
+
+	return m.MsigAddProposeFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) MsigApprove(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address) (out0 cid.Cid, err error) {
+	m.record("MsigApprove", in1, in2, in3)
+	if m.MsigApproveFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigApprove not mocked")
+		return
+	}
+
+	return m.MsigApproveFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) MsigApproveTxnHash(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 address.Address, in5 big.Int, in6 address.Address, in7 uint64, in8 []uint8) (out0 cid.Cid, err error) {
+	m.record("MsigApproveTxnHash", in1, in2, in3, in4, in5, in6, in7, in8)
+	if m.MsigApproveTxnHashFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigApproveTxnHash not mocked")
+		return
+	}
+
+	return m.MsigApproveTxnHashFunc(in0, in1, in2, in3, in4, in5, in6, in7, in8)
+}
+
+func (m *MockUnSupport) MsigCancel(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 big.Int, in5 address.Address, in6 uint64, in7 []uint8) (out0 cid.Cid, err error) {
+	m.record("MsigCancel", in1, in2, in3, in4, in5, in6, in7)
+	if m.MsigCancelFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigCancel not mocked")
+		return
+	}
+
+	return m.MsigCancelFunc(in0, in1, in2, in3, in4, in5, in6, in7)
+}
+
+func (m *MockUnSupport) MsigCreate(in0 context.Context, in1 uint64, in2 []address.Address, in3 abi.ChainEpoch, in4 big.Int, in5 address.Address, in6 big.Int) (out0 cid.Cid, err error) {
+	m.record("MsigCreate", in1, in2, in3, in4, in5, in6)
+	if m.MsigCreateFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigCreate not mocked")
+		return
+	}
+
+	return m.MsigCreateFunc(in0, in1, in2, in3, in4, in5, in6)
+}
+
+func (m *MockUnSupport) MsigGetAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("MsigGetAvailableBalance", in1, in2)
+	if m.MsigGetAvailableBalanceFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigGetAvailableBalance not mocked")
+		return
+	}
+
+	return m.MsigGetAvailableBalanceFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MsigGetPending(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*api1.MsigTransaction, err error) {
+	m.record("MsigGetPending", in1, in2)
+	if m.MsigGetPendingFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigGetPending not mocked")
+		return
+	}
+
+	return m.MsigGetPendingFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MsigGetVested(in0 context.Context, in1 address.Address, in2 types.TipSetKey, in3 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("MsigGetVested", in1, in2, in3)
+	if m.MsigGetVestedFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigGetVested not mocked")
+		return
+	}
+
+	return m.MsigGetVestedFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) MsigGetVestingSchedule(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MsigVesting, err error) {
+	m.record("MsigGetVestingSchedule", in1, in2)
+	if m.MsigGetVestingScheduleFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigGetVestingSchedule not mocked")
+		return
+	}
+
+	return m.MsigGetVestingScheduleFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) MsigPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int, in4 address.Address, in5 uint64, in6 []uint8) (out0 cid.Cid, err error) {
+	m.record("MsigPropose", in1, in2, in3, in4, in5, in6)
+	if m.MsigProposeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigPropose not mocked")
+		return
+	}
+
+	return m.MsigProposeFunc(in0, in1, in2, in3, in4, in5, in6)
+}
+
+func (m *MockUnSupport) MsigRemoveSigner(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 cid.Cid, err error) {
+	m.record("MsigRemoveSigner", in1, in2, in3, in4)
+	if m.MsigRemoveSignerFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigRemoveSigner not mocked")
+		return
+	}
+
+	return m.MsigRemoveSignerFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) MsigSwapApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 address.Address) (out0 cid.Cid, err error) {
+	m.record("MsigSwapApprove", in1, in2, in3, in4, in5, in6)
+	if m.MsigSwapApproveFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigSwapApprove not mocked")
+		return
+	}
+
+	return m.MsigSwapApproveFunc(in0, in1, in2, in3, in4, in5, in6)
+}
+
+func (m *MockUnSupport) MsigSwapCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address) (out0 cid.Cid, err error) {
+	m.record("MsigSwapCancel", in1, in2, in3, in4, in5)
+	if m.MsigSwapCancelFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigSwapCancel not mocked")
+		return
+	}
+
+	return m.MsigSwapCancelFunc(in0, in1, in2, in3, in4, in5)
+}
+
+func (m *MockUnSupport) MsigSwapPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 address.Address) (out0 cid.Cid, err error) {
+	m.record("MsigSwapPropose", in1, in2, in3, in4)
+	if m.MsigSwapProposeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.MsigSwapPropose not mocked")
+		return
+	}
+
+	return m.MsigSwapProposeFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) NetAddrsListen(in0 context.Context) (out0 peer.AddrInfo, err error) {
+	m.record("NetAddrsListen")
+	if m.NetAddrsListenFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetAddrsListen not mocked")
+		return
+	}
+
+	return m.NetAddrsListenFunc(in0)
+}
+
+func (m *MockUnSupport) NetAgentVersion(in0 context.Context, in1 peer.ID) (out0 string, err error) {
+	m.record("NetAgentVersion", in1)
+	if m.NetAgentVersionFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetAgentVersion not mocked")
+		return
+	}
+
+	return m.NetAgentVersionFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetAutoNatStatus(in0 context.Context) (out0 api1.NatInfo, err error) {
+	m.record("NetAutoNatStatus")
+	if m.NetAutoNatStatusFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetAutoNatStatus not mocked")
+		return
+	}
+
+	return m.NetAutoNatStatusFunc(in0)
+}
+
+func (m *MockUnSupport) NetBandwidthStats(in0 context.Context) (out0 metrics.Stats, err error) {
+	m.record("NetBandwidthStats")
+	if m.NetBandwidthStatsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBandwidthStats not mocked")
+		return
+	}
+
+	return m.NetBandwidthStatsFunc(in0)
+}
+
+func (m *MockUnSupport) NetBandwidthStatsByPeer(in0 context.Context) (out0 map[string]metrics.Stats, err error) {
+	m.record("NetBandwidthStatsByPeer")
+	if m.NetBandwidthStatsByPeerFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBandwidthStatsByPeer not mocked")
+		return
+	}
+
+	return m.NetBandwidthStatsByPeerFunc(in0)
+}
+
+func (m *MockUnSupport) NetBandwidthStatsByProtocol(in0 context.Context) (out0 map[protocol.ID]metrics.Stats, err error) {
+	m.record("NetBandwidthStatsByProtocol")
+	if m.NetBandwidthStatsByProtocolFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBandwidthStatsByProtocol not mocked")
+		return
+	}
+
+	return m.NetBandwidthStatsByProtocolFunc(in0)
+}
+
+func (m *MockUnSupport) NetBlockAdd(in0 context.Context, in1 api1.NetBlockList) (err error) {
+	m.record("NetBlockAdd", in1)
+	if m.NetBlockAddFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBlockAdd not mocked")
+		return
+	}
+
+	return m.NetBlockAddFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetBlockList(in0 context.Context) (out0 api1.NetBlockList, err error) {
+	m.record("NetBlockList")
+	if m.NetBlockListFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBlockList not mocked")
+		return
+	}
+
+	return m.NetBlockListFunc(in0)
+}
+
+func (m *MockUnSupport) NetBlockRemove(in0 context.Context, in1 api1.NetBlockList) (err error) {
+	m.record("NetBlockRemove", in1)
+	if m.NetBlockRemoveFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetBlockRemove not mocked")
+		return
+	}
+
+	return m.NetBlockRemoveFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetConnect(in0 context.Context, in1 peer.AddrInfo) (err error) {
+	m.record("NetConnect", in1)
+	if m.NetConnectFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetConnect not mocked")
+		return
+	}
+
+	return m.NetConnectFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetConnectedness(in0 context.Context, in1 peer.ID) (out0 network.Connectedness, err error) {
+	m.record("NetConnectedness", in1)
+	if m.NetConnectednessFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetConnectedness not mocked")
+		return
+	}
+
+	return m.NetConnectednessFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetDisconnect(in0 context.Context, in1 peer.ID) (err error) {
+	m.record("NetDisconnect", in1)
+	if m.NetDisconnectFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetDisconnect not mocked")
+		return
+	}
+
+	return m.NetDisconnectFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetFindPeer(in0 context.Context, in1 peer.ID) (out0 peer.AddrInfo, err error) {
+	m.record("NetFindPeer", in1)
+	if m.NetFindPeerFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetFindPeer not mocked")
+		return
+	}
+
+	return m.NetFindPeerFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetPeerInfo(in0 context.Context, in1 peer.ID) (out0 *api1.ExtendedPeerInfo, err error) {
+	m.record("NetPeerInfo", in1)
+	if m.NetPeerInfoFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetPeerInfo not mocked")
+		return
+	}
+
+	return m.NetPeerInfoFunc(in0, in1)
+}
+
+func (m *MockUnSupport) NetPeers(in0 context.Context) (out0 []peer.AddrInfo, err error) {
+	m.record("NetPeers")
+	if m.NetPeersFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetPeers not mocked")
+		return
+	}
+
+	return m.NetPeersFunc(in0)
+}
+
+func (m *MockUnSupport) NetPubsubScores(in0 context.Context) (out0 []api1.PubsubScore, err error) {
+	m.record("NetPubsubScores")
+	if m.NetPubsubScoresFunc == nil {
+		err = fmt.Errorf("MockUnSupport.NetPubsubScores not mocked")
+		return
+	}
+
+	return m.NetPubsubScoresFunc(in0)
+}
+
+func (m *MockUnSupport) PaychAllocateLane(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
+	m.record("PaychAllocateLane", in1)
+	if m.PaychAllocateLaneFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychAllocateLane not mocked")
+		return
+	}
+
+	return m.PaychAllocateLaneFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychAvailableFunds(in0 context.Context, in1 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
+	m.record("PaychAvailableFunds", in1)
+	if m.PaychAvailableFundsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychAvailableFunds not mocked")
+		return
+	}
+
+	return m.PaychAvailableFundsFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychAvailableFundsByFromTo(in0 context.Context, in1 address.Address, in2 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
+	m.record("PaychAvailableFundsByFromTo", in1, in2)
+	if m.PaychAvailableFundsByFromToFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychAvailableFundsByFromTo not mocked")
+		return
+	}
+
+	return m.PaychAvailableFundsByFromToFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) PaychCollect(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
+	m.record("PaychCollect", in1)
+	if m.PaychCollectFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychCollect not mocked")
+		return
+	}
+
+	return m.PaychCollectFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychGet(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 *api1.ChannelInfo, err error) {
+	m.record("PaychGet", in1, in2, in3)
+	if m.PaychGetFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychGet not mocked")
+		return
+	}
+
+	return m.PaychGetFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) PaychGetWaitReady(in0 context.Context, in1 cid.Cid) (out0 address.Address, err error) {
+	m.record("PaychGetWaitReady", in1)
+	if m.PaychGetWaitReadyFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychGetWaitReady not mocked")
+		return
+	}
+
+	return m.PaychGetWaitReadyFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychList(in0 context.Context) (out0 []address.Address, err error) {
+	m.record("PaychList")
+	if m.PaychListFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychList not mocked")
+		return
+	}
+
+	return m.PaychListFunc(in0)
+}
+
+func (m *MockUnSupport) PaychNewPayment(in0 context.Context, in1 address.Address, in2 address.Address, in3 []api1.VoucherSpec) (out0 *api1.PaymentInfo, err error) {
+	m.record("PaychNewPayment", in1, in2, in3)
+	if m.PaychNewPaymentFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychNewPayment not mocked")
+		return
+	}
+
+	return m.PaychNewPaymentFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) PaychSettle(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
+	m.record("PaychSettle", in1)
+	if m.PaychSettleFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychSettle not mocked")
+		return
+	}
+
+	return m.PaychSettleFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychStatus(in0 context.Context, in1 address.Address) (out0 *api1.PaychStatus, err error) {
+	m.record("PaychStatus", in1)
+	if m.PaychStatusFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychStatus not mocked")
+		return
+	}
+
+	return m.PaychStatusFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychVoucherAdd(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 big.Int) (out0 big.Int, err error) {
+	m.record("PaychVoucherAdd", in1, in2, in3, in4)
+	if m.PaychVoucherAddFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherAdd not mocked")
+		return
+	}
+
+	return m.PaychVoucherAddFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) PaychVoucherCheckSpendable(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 bool, err error) {
+	m.record("PaychVoucherCheckSpendable", in1, in2, in3, in4)
+	if m.PaychVoucherCheckSpendableFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherCheckSpendable not mocked")
+		return
+	}
+
+	return m.PaychVoucherCheckSpendableFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) PaychVoucherCheckValid(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher) (err error) {
+	m.record("PaychVoucherCheckValid", in1, in2)
+	if m.PaychVoucherCheckValidFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherCheckValid not mocked")
+		return
+	}
+
+	return m.PaychVoucherCheckValidFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) PaychVoucherCreate(in0 context.Context, in1 address.Address, in2 big.Int, in3 uint64) (out0 *api1.VoucherCreateResult, err error) {
+	m.record("PaychVoucherCreate", in1, in2, in3)
+	if m.PaychVoucherCreateFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherCreate not mocked")
+		return
+	}
+
+	return m.PaychVoucherCreateFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) PaychVoucherList(in0 context.Context, in1 address.Address) (out0 []*paych.SignedVoucher, err error) {
+	m.record("PaychVoucherList", in1)
+	if m.PaychVoucherListFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherList not mocked")
+		return
+	}
+
+	return m.PaychVoucherListFunc(in0, in1)
+}
+
+func (m *MockUnSupport) PaychVoucherSubmit(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 cid.Cid, err error) {
+	m.record("PaychVoucherSubmit", in1, in2, in3, in4)
+	if m.PaychVoucherSubmitFunc == nil {
+		err = fmt.Errorf("MockUnSupport.PaychVoucherSubmit not mocked")
+		return
+	}
+
+	return m.PaychVoucherSubmitFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) Session(in0 context.Context) (out0 uuid.UUID, err error) {
+	m.record("Session")
+	if m.SessionFunc == nil {
+		err = fmt.Errorf("MockUnSupport.Session not mocked")
+		return
+	}
+
+	return m.SessionFunc(in0)
+}
+
+func (m *MockUnSupport) Shutdown(in0 context.Context) (err error) {
+	m.record("Shutdown")
+	if m.ShutdownFunc == nil {
+		err = fmt.Errorf("MockUnSupport.Shutdown not mocked")
+		return
+	}
+
+	return m.ShutdownFunc(in0)
+}
+
+func (m *MockUnSupport) StateAccountKey(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	m.record("StateAccountKey", in1, in2)
+	if m.StateAccountKeyFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateAccountKey not mocked")
+		return
+	}
+
+	return m.StateAccountKeyFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateAllMinerFaults(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 []*api1.Fault, err error) {
+	m.record("StateAllMinerFaults", in1, in2)
+	if m.StateAllMinerFaultsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateAllMinerFaults not mocked")
+		return
+	}
+
+	return m.StateAllMinerFaultsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateCall(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 *api1.InvocResult, err error) {
+	m.record("StateCall", in1, in2)
+	if m.StateCallFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateCall not mocked")
+		return
+	}
+
+	return m.StateCallFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateChangedActors(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 map[string]types.Actor, err error) {
+	m.record("StateChangedActors", in1, in2)
+	if m.StateChangedActorsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateChangedActors not mocked")
+		return
+	}
+
+	return m.StateChangedActorsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateCirculatingSupply(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("StateCirculatingSupply", in1)
+	if m.StateCirculatingSupplyFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateCirculatingSupply not mocked")
+		return
+	}
+
+	return m.StateCirculatingSupplyFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateCompute(in0 context.Context, in1 abi.ChainEpoch, in2 []*types.Message, in3 types.TipSetKey) (out0 *api1.ComputeStateOutput, err error) {
+	m.record("StateCompute", in1, in2, in3)
+	if m.StateComputeFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateCompute not mocked")
+		return
+	}
+
+	return m.StateComputeFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateDealProviderCollateralBounds(in0 context.Context, in1 abi.PaddedPieceSize, in2 bool, in3 types.TipSetKey) (out0 api1.DealCollateralBounds, err error) {
+	m.record("StateDealProviderCollateralBounds", in1, in2, in3)
+	if m.StateDealProviderCollateralBoundsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateDealProviderCollateralBounds not mocked")
+		return
+	}
+
+	return m.StateDealProviderCollateralBoundsFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateDecodeParams(in0 context.Context, in1 address.Address, in2 abi.MethodNum, in3 []uint8, in4 types.TipSetKey) (out0 interface{}, err error) {
+	m.record("StateDecodeParams", in1, in2, in3, in4)
+	if m.StateDecodeParamsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateDecodeParams not mocked")
+		return
+	}
+
+	return m.StateDecodeParamsFunc(in0, in1, in2, in3, in4)
+}
+
+func (m *MockUnSupport) StateGetActor(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types.Actor, err error) {
+	m.record("StateGetActor", in1, in2)
+	if m.StateGetActorFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateGetActor not mocked")
+		return
+	}
+
+	return m.StateGetActorFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateGetReceipt(in0 context.Context, in1 cid.Cid, in2 types.TipSetKey) (out0 *types.MessageReceipt, err error) {
+	m.record("StateGetReceipt", in1, in2)
+	if m.StateGetReceiptFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateGetReceipt not mocked")
+		return
+	}
+
+	return m.StateGetReceiptFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateListActors(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
+	m.record("StateListActors", in1)
+	if m.StateListActorsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateListActors not mocked")
+		return
+	}
+
+	return m.StateListActorsFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateListMessages(in0 context.Context, in1 *api1.MessageMatch, in2 types.TipSetKey, in3 abi.ChainEpoch) (out0 []cid.Cid, err error) {
+	m.record("StateListMessages", in1, in2, in3)
+	if m.StateListMessagesFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateListMessages not mocked")
+		return
+	}
+
+	return m.StateListMessagesFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateListMiners(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
+	m.record("StateListMiners", in1)
+	if m.StateListMinersFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateListMiners not mocked")
+		return
+	}
+
+	return m.StateListMinersFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateLookupID(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	m.record("StateLookupID", in1, in2)
+	if m.StateLookupIDFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateLookupID not mocked")
+		return
+	}
+
+	return m.StateLookupIDFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMarketBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MarketBalance, err error) {
+	m.record("StateMarketBalance", in1, in2)
+	if m.StateMarketBalanceFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMarketBalance not mocked")
+		return
+	}
+
+	return m.StateMarketBalanceFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMarketDeals(in0 context.Context, in1 types.TipSetKey) (out0 map[string]api1.MarketDeal, err error) {
+	m.record("StateMarketDeals", in1)
+	if m.StateMarketDealsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMarketDeals not mocked")
+		return
+	}
+
+	return m.StateMarketDealsFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateMarketParticipants(in0 context.Context, in1 types.TipSetKey) (out0 map[string]api1.MarketBalance, err error) {
+	m.record("StateMarketParticipants", in1)
+	if m.StateMarketParticipantsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMarketParticipants not mocked")
+		return
+	}
+
+	return m.StateMarketParticipantsFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateMarketStorageDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *api1.MarketDeal, err error) {
+	m.record("StateMarketStorageDeal", in1, in2)
+	if m.StateMarketStorageDealFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMarketStorageDeal not mocked")
+		return
+	}
+
+	return m.StateMarketStorageDealFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerActiveSectors(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
+	m.record("StateMinerActiveSectors", in1, in2)
+	if m.StateMinerActiveSectorsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerActiveSectors not mocked")
+		return
+	}
+
+	return m.StateMinerActiveSectorsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("StateMinerAvailableBalance", in1, in2)
+	if m.StateMinerAvailableBalanceFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerAvailableBalance not mocked")
+		return
+	}
+
+	return m.StateMinerAvailableBalanceFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerDeadlines(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []api1.Deadline, err error) {
+	m.record("StateMinerDeadlines", in1, in2)
+	if m.StateMinerDeadlinesFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerDeadlines not mocked")
+		return
+	}
+
+	return m.StateMinerDeadlinesFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerFaults(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
+	m.record("StateMinerFaults", in1, in2)
+	if m.StateMinerFaultsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerFaults not mocked")
+		return
+	}
+
+	return m.StateMinerFaultsFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerInfo(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 miner.MinerInfo, err error) {
+	m.record("StateMinerInfo", in1, in2)
+	if m.StateMinerInfoFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerInfo not mocked")
+		return
+	}
+
+	return m.StateMinerInfoFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerInitialPledgeCollateral(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("StateMinerInitialPledgeCollateral", in1, in2, in3)
+	if m.StateMinerInitialPledgeCollateralFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerInitialPledgeCollateral not mocked")
+		return
+	}
+
+	return m.StateMinerInitialPledgeCollateralFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateMinerPartitions(in0 context.Context, in1 address.Address, in2 uint64, in3 types.TipSetKey) (out0 []api1.Partition, err error) {
+	m.record("StateMinerPartitions", in1, in2, in3)
+	if m.StateMinerPartitionsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerPartitions not mocked")
+		return
+	}
+
+	return m.StateMinerPartitionsFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateMinerPower(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.MinerPower, err error) {
+	m.record("StateMinerPower", in1, in2)
+	if m.StateMinerPowerFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerPower not mocked")
+		return
+	}
+
+	return m.StateMinerPowerFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerPreCommitDepositForPower(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
+	m.record("StateMinerPreCommitDepositForPower", in1, in2, in3)
+	if m.StateMinerPreCommitDepositForPowerFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerPreCommitDepositForPower not mocked")
+		return
+	}
+
+	return m.StateMinerPreCommitDepositForPowerFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateMinerProvingDeadline(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *dline.Info, err error) {
+	m.record("StateMinerProvingDeadline", in1, in2)
+	if m.StateMinerProvingDeadlineFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerProvingDeadline not mocked")
+		return
+	}
+
+	return m.StateMinerProvingDeadlineFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerRecoveries(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
+	m.record("StateMinerRecoveries", in1, in2)
+	if m.StateMinerRecoveriesFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerRecoveries not mocked")
+		return
+	}
+
+	return m.StateMinerRecoveriesFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerSectorAllocated(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 bool, err error) {
+	m.record("StateMinerSectorAllocated", in1, in2, in3)
+	if m.StateMinerSectorAllocatedFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerSectorAllocated not mocked")
+		return
+	}
+
+	return m.StateMinerSectorAllocatedFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateMinerSectorCount(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MinerSectors, err error) {
+	m.record("StateMinerSectorCount", in1, in2)
+	if m.StateMinerSectorCountFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerSectorCount not mocked")
+		return
+	}
+
+	return m.StateMinerSectorCountFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateMinerSectors(in0 context.Context, in1 address.Address, in2 *bitfield.BitField, in3 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
+	m.record("StateMinerSectors", in1, in2, in3)
+	if m.StateMinerSectorsFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateMinerSectors not mocked")
+		return
+	}
+
+	return m.StateMinerSectorsFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateNetworkName(in0 context.Context) (out0 dtypes.NetworkName, err error) {
+	m.record("StateNetworkName")
+	if m.StateNetworkNameFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateNetworkName not mocked")
+		return
+	}
+
+	return m.StateNetworkNameFunc(in0)
+}
+
+func (m *MockUnSupport) StateNetworkVersion(in0 context.Context, in1 types.TipSetKey) (out0 network1.Version, err error) {
+	m.record("StateNetworkVersion", in1)
+	if m.StateNetworkVersionFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateNetworkVersion not mocked")
+		return
+	}
+
+	return m.StateNetworkVersionFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateReadState(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.ActorState, err error) {
+	m.record("StateReadState", in1, in2)
+	if m.StateReadStateFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateReadState not mocked")
+		return
+	}
+
+	return m.StateReadStateFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateReplay(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid) (out0 *api1.InvocResult, err error) {
+	m.record("StateReplay", in1, in2)
+	if m.StateReplayFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateReplay not mocked")
+		return
+	}
+
+	return m.StateReplayFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateSearchMsg(in0 context.Context, in1 cid.Cid) (out0 *api1.MsgLookup, err error) {
+	m.record("StateSearchMsg", in1)
+	if m.StateSearchMsgFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSearchMsg not mocked")
+		return
+	}
+
+	return m.StateSearchMsgFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateSearchMsgLimited(in0 context.Context, in1 cid.Cid, in2 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
+	m.record("StateSearchMsgLimited", in1, in2)
+	if m.StateSearchMsgLimitedFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSearchMsgLimited not mocked")
+		return
+	}
+
+	return m.StateSearchMsgLimitedFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateSectorExpiration(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorExpiration, err error) {
+	m.record("StateSectorExpiration", in1, in2, in3)
+	if m.StateSectorExpirationFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSectorExpiration not mocked")
+		return
+	}
+
+	return m.StateSectorExpirationFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateSectorGetInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorOnChainInfo, err error) {
+	m.record("StateSectorGetInfo", in1, in2, in3)
+	if m.StateSectorGetInfoFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSectorGetInfo not mocked")
+		return
+	}
+
+	return m.StateSectorGetInfoFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateSectorPartition(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorLocation, err error) {
+	m.record("StateSectorPartition", in1, in2, in3)
+	if m.StateSectorPartitionFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSectorPartition not mocked")
+		return
+	}
+
+	return m.StateSectorPartitionFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateSectorPreCommitInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 miner.SectorPreCommitOnChainInfo, err error) {
+	m.record("StateSectorPreCommitInfo", in1, in2, in3)
+	if m.StateSectorPreCommitInfoFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateSectorPreCommitInfo not mocked")
+		return
+	}
+
+	return m.StateSectorPreCommitInfoFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.TipSetKey) (out0 api1.CirculatingSupply, err error) {
+	m.record("StateVMCirculatingSupplyInternal", in1)
+	if m.StateVMCirculatingSupplyInternalFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateVMCirculatingSupplyInternal not mocked")
+		return
+	}
+
+	return m.StateVMCirculatingSupplyInternalFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateVerifiedClientStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	m.record("StateVerifiedClientStatus", in1, in2)
+	if m.StateVerifiedClientStatusFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateVerifiedClientStatus not mocked")
+		return
+	}
+
+	return m.StateVerifiedClientStatusFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipSetKey) (out0 address.Address, err error) {
+	m.record("StateVerifiedRegistryRootKey", in1)
+	if m.StateVerifiedRegistryRootKeyFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateVerifiedRegistryRootKey not mocked")
+		return
+	}
+
+	return m.StateVerifiedRegistryRootKeyFunc(in0, in1)
+}
+
+func (m *MockUnSupport) StateVerifierStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	m.record("StateVerifierStatus", in1, in2)
+	if m.StateVerifierStatusFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateVerifierStatus not mocked")
+		return
+	}
+
+	return m.StateVerifierStatusFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64) (out0 *api1.MsgLookup, err error) {
+	m.record("StateWaitMsg", in1, in2)
+	if m.StateWaitMsgFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateWaitMsg not mocked")
+		return
+	}
+
+	return m.StateWaitMsgFunc(in0, in1, in2)
+}
+
+func (m *MockUnSupport) StateWaitMsgLimited(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
+	m.record("StateWaitMsgLimited", in1, in2, in3)
+	if m.StateWaitMsgLimitedFunc == nil {
+		err = fmt.Errorf("MockUnSupport.StateWaitMsgLimited not mocked")
+		return
+	}
+
+	return m.StateWaitMsgLimitedFunc(in0, in1, in2, in3)
+}
+
+func (m *MockUnSupport) SyncCheckBad(in0 context.Context, in1 cid.Cid) (out0 string, err error) {
+	m.record("SyncCheckBad", in1)
+	if m.SyncCheckBadFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncCheckBad not mocked")
+		return
+	}
+
+	return m.SyncCheckBadFunc(in0, in1)
+}
+
+func (m *MockUnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (err error) {
+	m.record("SyncCheckpoint", in1)
+	if m.SyncCheckpointFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncCheckpoint not mocked")
+		return
+	}
+
+	return m.SyncCheckpointFunc(in0, in1)
+}
+
+func (m *MockUnSupport) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.BlockHeader, err error) {
+	m.record("SyncIncomingBlocks")
+	if m.SyncIncomingBlocksFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncIncomingBlocks not mocked")
+		return
+	}
+
+	return m.SyncIncomingBlocksFunc(in0)
+}
+
+func (m *MockUnSupport) SyncMarkBad(in0 context.Context, in1 cid.Cid) (err error) {
+	m.record("SyncMarkBad", in1)
+	if m.SyncMarkBadFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncMarkBad not mocked")
+		return
+	}
+
+	return m.SyncMarkBadFunc(in0, in1)
+}
+
+func (m *MockUnSupport) SyncUnmarkAllBad(in0 context.Context) (err error) {
+	m.record("SyncUnmarkAllBad")
+	if m.SyncUnmarkAllBadFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncUnmarkAllBad not mocked")
+		return
+	}
+
+	return m.SyncUnmarkAllBadFunc(in0)
+}
+
+func (m *MockUnSupport) SyncUnmarkBad(in0 context.Context, in1 cid.Cid) (err error) {
+	m.record("SyncUnmarkBad", in1)
+	if m.SyncUnmarkBadFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncUnmarkBad not mocked")
+		return
+	}
+
+	return m.SyncUnmarkBadFunc(in0, in1)
+}
+
+func (m *MockUnSupport) SyncValidateTipset(in0 context.Context, in1 types.TipSetKey) (out0 bool, err error) {
+	m.record("SyncValidateTipset", in1)
+	if m.SyncValidateTipsetFunc == nil {
+		err = fmt.Errorf("MockUnSupport.SyncValidateTipset not mocked")
+		return
+	}
+
+	return m.SyncValidateTipsetFunc(in0, in1)
+}
+
+func (m *MockUnSupport) Version(in0 context.Context) (out0 api1.APIVersion, err error) {
+	m.record("Version")
+	if m.VersionFunc == nil {
+		err = fmt.Errorf("MockUnSupport.Version not mocked")
+		return
+	}
+
+	return m.VersionFunc(in0)
+}
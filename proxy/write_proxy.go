@@ -0,0 +1,121 @@
+package proxy
+
+import (
+	"context"
+	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+)
+
+var _ WriteProxyAPI = (*WriteProxy)(nil)
+
+type WriteProxyAPI interface {
+	api.WriteProxy
+}
+
+type WriteProxy struct {
+	// Select chooses the upstream for the method, args are the params except the context
+	Select func(ctx context.Context, method string, args ...interface{}) (WriteProxyAPI, error)
+
+	// Wrap is optional, it will be called around each call to the upstream if set.
+	// The call selects the upstream and calls it, the results are only set by the returned func,
+	// so that the call can be made more than once, even concurrently, and one of the results is taken
+	Wrap func(ctx context.Context, method string, args []interface{}, call func(context.Context) (commit func(), err error)) error
+}
+
+// WriteProxyPerms maps the methods to the required permissions
+var WriteProxyPerms = map[string]string{
+	"MpoolBatchPush":          "write",
+	"MpoolBatchPushUntrusted": "write",
+	"MpoolPush":               "write",
+	"MpoolPushUntrusted":      "write",
+}
+
+// impl api.WriteProxy
+func (p *WriteProxy) MpoolBatchPush(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolBatchPush", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolBatchPush", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolBatchPush(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolBatchPush", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolBatchPush(in0, in1)
+}
+
+func (p *WriteProxy) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolBatchPushUntrusted", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolBatchPushUntrusted", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolBatchPushUntrusted(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolBatchPushUntrusted", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolBatchPushUntrusted(in0, in1)
+}
+
+func (p *WriteProxy) MpoolPush(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolPush", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolPush", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolPush(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolPush", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolPush(in0, in1)
+}
+
+func (p *WriteProxy) MpoolPushUntrusted(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
+	if p.Wrap != nil {
+		err = p.Wrap(in0, "MpoolPushUntrusted", []interface{}{in1}, func(in0 context.Context) (func(), error) {
+			cli, err := p.Select(in0, "MpoolPushUntrusted", in1)
+			if err != nil {
+				return nil, err
+			}
+
+			ret0, err := cli.MpoolPushUntrusted(in0, in1)
+			return func() { out0 = ret0 }, err
+		})
+		return
+	}
+
+	cli, err := p.Select(in0, "MpoolPushUntrusted", in1)
+	if err != nil {
+		return
+	}
+
+	return cli.MpoolPushUntrusted(in0, in1)
+}
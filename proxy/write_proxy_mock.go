@@ -0,0 +1,104 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"github.com/dtynn/chain-co/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"sync"
+)
+
+var _ WriteProxyAPI = (*MockWriteProxy)(nil)
+var _ api.WriteProxy = (*MockWriteProxy)(nil)
+
+// MockWriteProxyCall is a call recorded by MockWriteProxy, Args are the params except the context
+type MockWriteProxyCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// MockWriteProxy is a mock implementation of WriteProxyAPI, methods without a fake func return zero values and an error
+type MockWriteProxy struct {
+	MpoolBatchPushFunc          func(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
+	MpoolBatchPushUntrustedFunc func(context.Context, []*types.SignedMessage) ([]cid.Cid, error)
+	MpoolPushFunc               func(context.Context, *types.SignedMessage) (cid.Cid, error)
+	MpoolPushUntrustedFunc      func(context.Context, *types.SignedMessage) (cid.Cid, error)
+
+	calls struct {
+		sync.Mutex
+		records []MockWriteProxyCall
+	}
+}
+
+func (m *MockWriteProxy) record(method string, args ...interface{}) {
+	m.calls.Lock()
+	m.calls.records = append(m.calls.records, MockWriteProxyCall{Method: method, Args: args})
+	m.calls.Unlock()
+}
+
+// Calls returns the recorded calls
+func (m *MockWriteProxy) Calls() []MockWriteProxyCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	calls := make([]MockWriteProxyCall, len(m.calls.records))
+	copy(calls, m.calls.records)
+	return calls
+}
+
+// CallsOf returns the recorded calls of the given method
+func (m *MockWriteProxy) CallsOf(method string) []MockWriteProxyCall {
+	m.calls.Lock()
+	defer m.calls.Unlock()
+
+	var calls []MockWriteProxyCall
+	for _, call := range m.calls.records {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// impl api.WriteProxy
+func (m *MockWriteProxy) MpoolBatchPush(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
+	m.record("MpoolBatchPush", in1)
+	if m.MpoolBatchPushFunc == nil {
+		err = fmt.Errorf("MockWriteProxy.MpoolBatchPush not mocked")
+		return
+	}
+
+	return m.MpoolBatchPushFunc(in0, in1)
+}
+
+func (m *MockWriteProxy) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
+	m.record("MpoolBatchPushUntrusted", in1)
+	if m.MpoolBatchPushUntrustedFunc == nil {
+		err = fmt.Errorf("MockWriteProxy.MpoolBatchPushUntrusted not mocked")
+		return
+	}
+
+	return m.MpoolBatchPushUntrustedFunc(in0, in1)
+}
+
+func (m *MockWriteProxy) MpoolPush(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
+	m.record("MpoolPush", in1)
+	if m.MpoolPushFunc == nil {
+		err = fmt.Errorf("MockWriteProxy.MpoolPush not mocked")
+		return
+	}
+
+	return m.MpoolPushFunc(in0, in1)
+}
+
+func (m *MockWriteProxy) MpoolPushUntrusted(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
+	m.record("MpoolPushUntrusted", in1)
+	if m.MpoolPushUntrustedFunc == nil {
+		err = fmt.Errorf("MockWriteProxy.MpoolPushUntrusted not mocked")
+		return
+	}
+
+	return m.MpoolPushUntrustedFunc(in0, in1)
+}